@@ -0,0 +1,154 @@
+package hellosign
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+)
+
+// GetAccount – Returns the properties and settings of the authenticated account.
+func (m *Client) GetAccount() (*model.Account, error) {
+	response, err := m.get("account")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseAccountResponse(response)
+}
+
+// UpdateAccount – Updates the properties and settings of the authenticated account.
+func (m *Client) UpdateAccount(req model.UpdateAccountRequest) (*model.Account, error) {
+	var params bytes.Buffer
+	writer, err := m.newMultipartWriter(&params)
+	if err != nil {
+		return nil, err
+	}
+
+	structType := reflect.TypeOf(req)
+	val := reflect.ValueOf(req)
+
+	for i := 0; i < val.NumField(); i++ {
+		valueField := val.Field(i)
+		field := structType.Field(i)
+		fieldTag := field.Tag.Get(FormFieldKey)
+
+		if valueField.String() != "" {
+			formField, err := writer.CreateFormField(fieldTag)
+			if err != nil {
+				return nil, err
+			}
+			formField.Write([]byte(valueField.String()))
+		}
+	}
+	writer.Close()
+
+	response, err := m.post("account", &params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseAccountResponse(response)
+}
+
+// CreateAccount – Creates a new HelloSign account associated with the email address.
+func (m *Client) CreateAccount(email string) (*model.Account, *model.OAuthData, error) {
+	return m.CreateAccountWithOAuth(email, "", "")
+}
+
+// CreateAccountWithOAuth – Creates a new HelloSign account and, when the app's clientID and clientSecret
+// are provided, returns OAuth credentials for it in the same response.
+func (m *Client) CreateAccountWithOAuth(email string, clientID string, clientSecret string) (*model.Account, *model.OAuthData, error) {
+	var params bytes.Buffer
+	writer, err := m.newMultipartWriter(&params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	emailField, err := writer.CreateFormField(EmailKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	emailField.Write([]byte(email))
+
+	if clientID != "" {
+		clientIDField, err := writer.CreateFormField(ClientIDKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		clientIDField.Write([]byte(clientID))
+	}
+
+	if clientSecret != "" {
+		clientSecretField, err := writer.CreateFormField("client_secret")
+		if err != nil {
+			return nil, nil, err
+		}
+		clientSecretField.Write([]byte(clientSecret))
+	}
+	writer.Close()
+
+	response, err := m.post("account/create", &params, *writer)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer response.Body.Close()
+
+	resp := &model.AccountResponse{}
+	err = json.NewDecoder(response.Body).Decode(resp)
+
+	return resp.GetAccount(), resp.GetOAuth(), err
+}
+
+// marshalMultipartAccountIdentifier – Writes whichever one of accountID or email identifies an account, as HelloSign requires exactly one.
+// Any non-empty extra fields are written alongside it.
+func (m *Client) marshalMultipartAccountIdentifier(accountID string, email string, extra map[string]string) (*bytes.Buffer, *multipart.Writer, error) {
+	if (accountID == "") == (email == "") {
+		return nil, nil, fmt.Errorf("exactly one of %s or %s must be provided", AccountIDKey, EmailKey)
+	}
+
+	var b bytes.Buffer
+	w, err := m.newMultipartWriter(&b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fieldTag, value := AccountIDKey, accountID
+	if email != "" {
+		fieldTag, value = EmailKey, email
+	}
+
+	formField, err := w.CreateFormField(fieldTag)
+	if err != nil {
+		return nil, nil, err
+	}
+	formField.Write([]byte(value))
+
+	for _, k := range m.sortedKeys(extra) {
+		v := extra[k]
+		if v == "" {
+			continue
+		}
+		extraField, err := w.CreateFormField(k)
+		if err != nil {
+			return nil, nil, err
+		}
+		extraField.Write([]byte(v))
+	}
+
+	w.Close()
+	return &b, w, nil
+}
+
+// parseAccountResponse – Parses the account response and converts it into the account model
+func (m *Client) parseAccountResponse(response *http.Response) (*model.Account, error) {
+	defer response.Body.Close()
+
+	resp := &model.AccountResponse{}
+	err := json.NewDecoder(response.Body).Decode(resp)
+
+	return resp.GetAccount(), err
+}
@@ -0,0 +1,69 @@
+package hellosign
+
+import (
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestClient_GetAccount(t *testing.T) {
+	vcr := fixture("fixtures/account/get_account")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.GetAccount()
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "5008b25c7f67153e57d5a357b1687968068fb465", res.GetAccountID())
+	assert.Equal(t, "me@hellosign.com", res.GetEmailAddress())
+	assert.Equal(t, "", res.GetCallbackURL())
+	assert.True(t, res.GetIsPaidHS())
+	assert.False(t, res.GetIsPaidHF())
+	assert.Equal(t, 1250, res.GetQuotas().GetAPISignatureRequestsLeft())
+	assert.Equal(t, 5, res.GetQuotas().GetTemplatesLeft())
+}
+
+func TestClient_UpdateAccount(t *testing.T) {
+	vcr := fixture("fixtures/account/update_account")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.UpdateAccount(model.UpdateAccountRequest{
+		CallbackURL: "https://www.example.com/callback",
+		Locale:      "fr-FR",
+	})
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "https://www.example.com/callback", res.GetCallbackURL())
+	assert.Equal(t, "fr-FR", res.GetLocale())
+}
+
+func TestClient_CreateAccountWithOAuth(t *testing.T) {
+	vcr := fixture("fixtures/account/create_account")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	account, oauth, err := client.CreateAccountWithOAuth(
+		"newuser@example.com",
+		"0dd3b823a682527788c4e40cb7b6f7e9",
+		"c3a4f9c1b2d8e7f6a5b4c3d2e1f0a9b8",
+	)
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, account, "Should return account")
+	require.NotNil(t, oauth, "Should return oauth data")
+
+	assert.Equal(t, "a2b31224f7e6f3c3b2d9c1e4f5a6b7c8d9e0f1a2", account.GetAccountID())
+	assert.Equal(t, "newuser@example.com", account.GetEmailAddress())
+
+	assert.Equal(t, "NWNiOTMxOGFkOGVjMDhhNTAxZN2NkNjgxMjMwOWJiYTEzZTBmZGUzMjMThhMzYyMzc=", oauth.GetAccessToken())
+	assert.Equal(t, "Bearer", oauth.GetTokenType())
+	assert.Equal(t, "hNTI2MTFmM2VmZDQxZTZjOWRmZmFjZmVmMGMyNGFjMzI2MGI5YzgzNmE3", oauth.GetRefreshToken())
+	assert.Equal(t, 86400, oauth.GetExpiresIn())
+	assert.Equal(t, "basic_account_info,request_signature", oauth.GetScope())
+}
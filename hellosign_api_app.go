@@ -3,10 +3,10 @@ package hellosign
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"github.com/DeputyApp/hellosign-go-sdk/model"
-	"io"
 	"mime/multipart"
-	"os"
+	"net/http"
 	"reflect"
 )
 
@@ -16,8 +16,81 @@ const (
 
 // CreateNewApiApp – Creates a new API App.
 func (m *Client) CreateNewApiApp(req model.CreateApiAppRequest) (*model.APIApp, error) {
+	return m.CreateAPIApp(model.APIAppRequest{
+		Name:                 req.Name,
+		Domain:               req.Domain,
+		CallbackURL:          req.CallbackURL,
+		CustomLogoFile:       req.CustomLogoFile,
+		WhiteLabelingOptions: req.WhiteLabelingOptions,
+	})
+}
+
+// CreateAPIApp – Creates a new API App.
+func (m *Client) CreateAPIApp(req model.APIAppRequest) (*model.APIApp, error) {
+	return m.postAPIApp("api_app", req)
+}
+
+// GetAPIApp – Returns the API App specified by the client_id.
+func (m *Client) GetAPIApp(clientID string) (*model.APIApp, error) {
+	response, err := m.get(fmt.Sprintf("api_app/%s", clientID))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseAPIAppResponse(response)
+}
+
+// ListAPIApps – Returns a page of the API Apps that are accessible by you.
+func (m *Client) ListAPIApps(params model.ListParams) (*model.ListAPIAppsResponse, error) {
+	response, err := m.getWithQuery("api_app/list", listParams(params).encode())
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	listResponse := &model.ListAPIAppsResponse{}
+	err = json.NewDecoder(response.Body).Decode(listResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return listResponse, err
+}
+
+// UpdateAPIApp – Updates the API App specified by the client_id. Empty fields are left unchanged.
+func (m *Client) UpdateAPIApp(clientID string, req model.APIAppRequest) (*model.APIApp, error) {
+	return m.postAPIApp(fmt.Sprintf("api_app/%s", clientID), req)
+}
+
+// DeleteAPIApp – Deletes the API App specified by the client_id. A rejected delete is returned as a *model.APIError.
+func (m *Client) DeleteAPIApp(clientID string) (*model.OperationResult, error) {
+	response, err := m.delete(fmt.Sprintf("api_app/%s", clientID))
+	if err != nil {
+		return nil, err
+	}
+	return m.parseOperationResult(response)
+}
+
+func (m *Client) postAPIApp(path string, req model.APIAppRequest) (*model.APIApp, error) {
+	params, writer, err := m.marshalMultipartAPIAppRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post(path, params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseAPIAppResponse(response)
+}
+
+func (m *Client) marshalMultipartAPIAppRequest(req model.APIAppRequest) (*bytes.Buffer, *multipart.Writer, error) {
 	var params bytes.Buffer
-	writer := multipart.NewWriter(&params)
+	writer, err := m.newMultipartWriter(&params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	structType := reflect.TypeOf(req)
 	val := reflect.ValueOf(req)
@@ -33,20 +106,13 @@ func (m *Client) CreateNewApiApp(req model.CreateApiAppRequest) (*model.APIApp,
 		default:
 			if val.String() != "" {
 				if fieldTag == HellosignCustomLogoFileKey {
-					path := val.String()
-					file, err := os.Open(path)
-					if err != nil {
-						return nil, err
+					if err := m.writeFilePath(writer, fieldTag, val.String()); err != nil {
+						return nil, nil, err
 					}
-					formField, err := writer.CreateFormFile(fieldTag, file.Name())
-					if err != nil {
-						return nil, err
-					}
-					_, err = io.Copy(formField, file)
 				} else {
 					formField, err := writer.CreateFormField(fieldTag)
 					if err != nil {
-						return nil, err
+						return nil, nil, err
 					}
 					formField.Write([]byte(val.String()))
 				}
@@ -55,12 +121,15 @@ func (m *Client) CreateNewApiApp(req model.CreateApiAppRequest) (*model.APIApp,
 	}
 	writer.Close()
 
-	response, err := m.post("api_app", &params, *writer)
-	if err != nil {
-		return nil, err
-	}
+	return &params, writer, nil
+}
+
+// parseAPIAppResponse – Parses the api_app response and converts it into the API App model
+func (m *Client) parseAPIAppResponse(response *http.Response) (*model.APIApp, error) {
 	defer response.Body.Close()
+
 	resp := &model.CreateAPIAppResponse{}
-	err = json.NewDecoder(response.Body).Decode(resp)
+	err := json.NewDecoder(response.Body).Decode(resp)
+
 	return resp.GetAPIApp(), err
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/DeputyApp/hellosign-go-sdk/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"net/http"
 	"testing"
 )
 
@@ -36,3 +37,84 @@ func TestClient_CreateNewApiApp(t *testing.T) {
 	assert.Equal(t, expectedWhiteLabelingOptions, res.GetWhiteLabelingOptions())
 	assert.NotEmpty(t, res.GetCreatedAt())
 }
+
+func TestClient_CreateAPIApp(t *testing.T) {
+	vcr := fixture("fixtures/api_app/create_api_app_oauth")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.CreateAPIApp(model.APIAppRequest{
+		Name:             "Partner App",
+		Domain:           "example.com",
+		OAuthCallbackURL: "https://www.example.com/oauth",
+		OAuthScopes:      "basic_account_info,request_signature",
+	})
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "b7f1e38c87bd2c5cb0bd0453e1e4b4ac", res.GetClientID())
+	assert.Equal(t, "Partner App", res.GetName())
+	assert.False(t, res.GetIsApproved())
+	assert.Equal(t, "https://www.example.com/oauth", res.GetOAuth().GetCallbackURL())
+	assert.Equal(t, []string{"basic_account_info", "request_signature"}, res.GetOAuth().GetScopes())
+}
+
+func TestClient_ListAPIApps(t *testing.T) {
+	vcr := fixture("fixtures/api_app/list_api_apps")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.ListAPIApps(model.ListParams{Page: 1, PageSize: 2})
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, 2, res.GetListInfo().GetNumPages())
+	require.Len(t, res.GetAPIApps(), 2)
+	assert.Equal(t, "ef3a192c21281d79703ea0574da579a9", res.GetAPIApps()[0].GetClientID())
+	assert.Nil(t, res.GetAPIApps()[0].GetOAuth())
+	assert.Equal(t, "Partner App", res.GetAPIApps()[1].GetName())
+}
+
+func TestClient_UpdateAPIApp(t *testing.T) {
+	var path string
+	var values map[string][]string
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		path = r.URL.Path
+		values = readRequestForm(t, r).Value
+		return stubResponse(200, `{"api_app":{"client_id":"ef3a192c21281d79703ea0574da579a9","name":"Renamed App"}}`), nil
+	})
+
+	res, err := client.UpdateAPIApp("ef3a192c21281d79703ea0574da579a9", model.APIAppRequest{Name: "Renamed App"})
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, "/v3/api_app/ef3a192c21281d79703ea0574da579a9", path)
+	assert.Equal(t, []string{"Renamed App"}, values["name"])
+	assert.NotContains(t, values, "domain", "Should leave empty fields unchanged")
+	assert.Equal(t, "Renamed App", res.GetName())
+}
+
+func TestClient_DeleteAPIApp(t *testing.T) {
+	var method string
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		method = r.Method
+		return stubResponse(204, ""), nil
+	})
+
+	res, err := client.DeleteAPIApp("ef3a192c21281d79703ea0574da579a9")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, "DELETE", method)
+	assert.Equal(t, 204, res.GetStatusCode())
+}
+
+func TestClient_DeleteAPIAppError(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		return stubResponse(404, `{"error":{"error_msg":"Not found","error_name":"not_found"}}`), nil
+	})
+
+	res, err := client.DeleteAPIApp("ef3a192c21281d79703ea0574da579a9")
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, "not_found: Not found")
+}
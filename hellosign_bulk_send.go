@@ -0,0 +1,83 @@
+package hellosign
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"mime/multipart"
+	"net/http"
+)
+
+// BulkSendWithTemplate – Creates a BulkSendJob that sends a signature request based on the templates to each
+// entry of the signer_file CSV or signer_list.
+func (m *Client) BulkSendWithTemplate(req model.BulkSendWithTemplateRequest) (*model.BulkSendJob, error) {
+	params, writer, err := m.marshalMultipartBulkSendWithTemplateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post("signature_request/bulk_send_with_template", params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseBulkSendJobResponse(response)
+}
+
+// GetBulkSendJob – Returns the status of the BulkSendJob and a page of the signature requests it created.
+func (m *Client) GetBulkSendJob(jobID string, params model.ListParams) (*model.BulkSendJob, error) {
+	response, err := m.getWithQuery(fmt.Sprintf("bulk_send_job/%s", jobID), listParams(params).encode())
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	resp := &model.BulkSendJobResponse{}
+	err = json.NewDecoder(response.Body).Decode(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	job := resp.GetBulkSendJob()
+	if job != nil {
+		job.ListInfo = resp.GetListInfo()
+		job.SignatureRequests = resp.GetSignatureRequests()
+	}
+	return job, nil
+}
+
+// ListBulkSendJobs – Returns a page of the BulkSendJobs that you have access to.
+func (m *Client) ListBulkSendJobs(params model.ListParams) (*model.ListBulkSendJobsResponse, error) {
+	response, err := m.getWithQuery("bulk_send_job/list", listParams(params).encode())
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	listResponse := &model.ListBulkSendJobsResponse{}
+	err = json.NewDecoder(response.Body).Decode(listResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return listResponse, err
+}
+
+func (m *Client) marshalMultipartBulkSendWithTemplateRequest(req model.BulkSendWithTemplateRequest) (*bytes.Buffer, *multipart.Writer, error) {
+	if (req.SignerFile == "") == (len(req.SignerList) == 0) {
+		return nil, nil, fmt.Errorf("exactly one of %s or %s must be provided", SignerFileKey, SignerListKey)
+	}
+
+	return m.marshalMultipartSignatureWithTemplateRequest(req, nil)
+}
+
+// parseBulkSendJobResponse – Parses the bulk send job response and converts it into the bulk send job model
+func (m *Client) parseBulkSendJobResponse(response *http.Response) (*model.BulkSendJob, error) {
+	defer response.Body.Close()
+
+	resp := &model.BulkSendJobResponse{}
+	err := json.NewDecoder(response.Body).Decode(resp)
+
+	return resp.GetBulkSendJob(), err
+}
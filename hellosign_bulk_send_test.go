@@ -0,0 +1,147 @@
+package hellosign
+
+import (
+	"encoding/json"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestClient_BulkSendWithTemplate(t *testing.T) {
+	vcr := fixture("fixtures/bulk_send/bulk_send_with_template")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.BulkSendWithTemplate(createBulkSendWithTemplateRequest())
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174", res.GetBulkSendJobID())
+	assert.Equal(t, 2, res.GetTotal())
+	assert.True(t, res.GetIsCreator())
+}
+
+func TestClient_GetBulkSendJob(t *testing.T) {
+	vcr := fixture("fixtures/bulk_send/get_bulk_send_job")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.GetBulkSendJob("6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174", model.ListParams{Page: 1, PageSize: 20})
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174", res.GetBulkSendJobID())
+	assert.Equal(t, 2, res.GetListInfo().GetNumResults())
+
+	require.Len(t, res.GetSignatureRequests(), 2)
+	assert.True(t, res.GetSignatureRequests()[0].GetIsComplete())
+	assert.Equal(t, "jane@example.com", res.GetSignatureRequests()[0].GetSignatures()[0].GetSignerEmailAddress())
+	assert.Equal(t, "awaiting_signature", res.GetSignatureRequests()[1].GetSignatures()[0].GetStatusCode())
+}
+
+func TestClient_ListBulkSendJobs(t *testing.T) {
+	vcr := fixture("fixtures/bulk_send/list_bulk_send_jobs")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.ListBulkSendJobs(model.ListParams{})
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, 1, res.GetListInfo().GetNumPages())
+	require.Len(t, res.GetBulkSendJobs(), 2)
+	assert.Equal(t, 150, res.GetBulkSendJobs()[1].GetTotal())
+}
+
+func TestBulkSendWithTemplateSignerFileMarshalling(t *testing.T) {
+	client := Client{}
+
+	req := createBulkSendWithTemplateRequest()
+	req.SignerList = nil
+	req.SignerFile = "fixtures/bulk_send/signers.csv"
+
+	params, writer, err := client.marshalMultipartBulkSendWithTemplateRequest(req)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	require.Len(t, form.File[SignerFileKey], 1)
+	upload := form.File[SignerFileKey][0]
+	assert.Equal(t, "signers.csv", filepath.Base(upload.Filename))
+
+	file, err := upload.Open()
+	require.Nil(t, err, "Should open the file part")
+	defer file.Close()
+	contents, _ := ioutil.ReadAll(file)
+	assert.Contains(t, string(contents), "Employee::email_address")
+
+	assert.NotContains(t, form.Value, SignerFileKey)
+	assert.NotContains(t, form.Value, SignerListKey)
+}
+
+func TestBulkSendWithTemplateSignerListMarshalling(t *testing.T) {
+	client := Client{}
+
+	params, writer, err := client.marshalMultipartBulkSendWithTemplateRequest(createBulkSendWithTemplateRequest())
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"f57db65d3f933b5316d398057a36176831451a35"}, form.Value["template_ids[0]"])
+	require.Len(t, form.Value[SignerListKey], 1)
+
+	var signerList []map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(form.Value[SignerListKey][0]), &signerList), "Should send a JSON array")
+	require.Len(t, signerList, 2)
+	assert.Equal(t, map[string]interface{}{
+		"signers": map[string]interface{}{
+			"Employee": map[string]interface{}{"name": "Jane Doe", "email_address": "jane@example.com"},
+		},
+		"custom_fields": map[string]interface{}{"Salary": "$100000"},
+	}, signerList[0])
+}
+
+func TestBulkSendWithTemplateRequiresOneSignerSource(t *testing.T) {
+	client := Client{}
+
+	req := createBulkSendWithTemplateRequest()
+	req.SignerFile = "fixtures/bulk_send/signers.csv"
+
+	_, _, err := client.marshalMultipartBulkSendWithTemplateRequest(req)
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, "exactly one of signer_file or signer_list must be provided", err.Error())
+
+	req.SignerFile = ""
+	req.SignerList = nil
+
+	_, _, err = client.marshalMultipartBulkSendWithTemplateRequest(req)
+	require.NotNil(t, err, "Should return error")
+}
+
+func createBulkSendWithTemplateRequest() model.BulkSendWithTemplateRequest {
+	return model.BulkSendWithTemplateRequest{
+		TestMode:    true,
+		TemplateIDs: []string{"f57db65d3f933b5316d398057a36176831451a35"},
+		Subject:     "Offer letter",
+		SignerList: []model.BulkSignerList{
+			{
+				Signers: map[string]model.BulkSigner{
+					"Employee": {Name: "Jane Doe", Email: "jane@example.com"},
+				},
+				CustomFields: map[string]string{"Salary": "$100000"},
+			},
+			{
+				Signers: map[string]model.BulkSigner{
+					"Employee": {Name: "John Doe", Email: "john@example.com"},
+				},
+				CustomFields: map[string]string{"Salary": "$90000"},
+			},
+		},
+	}
+}
@@ -2,16 +2,24 @@ package hellosign
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/DeputyApp/hellosign-go-sdk/model"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
@@ -22,13 +30,100 @@ const (
 	FormFieldsPerDocKey string = "form_fields_per_document"
 	CustomFieldsKey     string = "custom_fields"
 	FormFieldKey        string = "form_field"
+	TemplateIDsKey      string = "template_ids"
+	CCsKey              string = "ccs"
+	SignerFileKey       string = "signer_file"
+	SignerListKey       string = "signer_list"
+	AttachmentsKey      string = "attachments"
+	ExpiresAtKey        string = "expires_at"
+	UseTextTagsKey      string = "use_text_tags"
+	HideTextTagsKey     string = "hide_text_tags"
+
+	defaultTimeout = 30 * time.Second
+
+	// Environment variables read by NewClientFromEnv
+	APIKeyEnv  string = "HELLOSIGN_API_KEY"
+	BaseURLEnv string = "HELLOSIGN_BASE_URL"
+
+	// Version of the SDK, sent in the default User-Agent
+	Version          string = "1.0.0"
+	defaultUserAgent string = "hellosign-go-sdk/" + Version
 )
 
+// ErrSignatureRequestDeclined is returned by WaitForCompletion when a signer declines the request
+var ErrSignatureRequestDeclined = errors.New("signature request was declined")
+
 // Client contains APIKey and optional http.client
+// When both APIKey and AccessToken are set, requests authenticate with the AccessToken.
 type Client struct {
-	APIKey     string
-	BaseURL    string
-	HTTPClient *http.Client
+	APIKey      string
+	AccessToken string // OAuth access token used as a Bearer token to act on behalf of another account.
+	BaseURL     string
+	HTTPClient  Doer         // Optional. Any *http.Client, or a stub in tests. Defaults to an http.Client with a 30 second timeout.
+	RetryPolicy *RetryPolicy // Optional. Requests are not retried when nil.
+	RateLimiter RateLimiter  // Optional. Every request, including retries, waits on it before being sent. Requests are not limited when nil.
+	UserAgent   string       // Optional. Defaults to "hellosign-go-sdk/<Version>".
+
+	// ForceTestMode sends test_mode=1 on every request regardless of the request's TestMode, e.g. to guarantee CI never sends real requests.
+	ForceTestMode bool
+
+	IdempotencyStore IdempotencyStore // Optional. Defaults to an in-memory store shared by the process, keeping keys scoped to the credential for DefaultIdempotencyTTL.
+
+	// SignatureRequestCache is optional. When set, GetSignatureRequest serves completed signature requests from it.
+	SignatureRequestCache *SignatureRequestCache
+
+	// SignURLRetryPolicy is optional. When set, GetEmbeddedSignURL retries while HelloSign reports the signature isn't ready,
+	// as happens right after an embedded request is created. Only MaxRetries and Backoff are used.
+	SignURLRetryPolicy *RetryPolicy
+
+	// MultipartBoundary is optional. When set, request bodies use it instead of a random boundary so the same request
+	// always marshals to the same bytes, eg: for golden-file tests.
+	MultipartBoundary string
+
+	lastRateLimit  atomic.Value   // *model.RateLimit from the most recent response that reported one
+	requestOptions RequestOptions // Set by WithRequestOptions.
+}
+
+// NewClient creates a Client for the production API with a default http.Client that times out
+func NewClient(apiKey string) *Client {
+	return NewClientWithHTTPClient(apiKey, &http.Client{Timeout: defaultTimeout})
+}
+
+// NewClientFromEnv creates a Client like NewClient, reading the API key from HELLOSIGN_API_KEY and,
+// when set, the base URL from HELLOSIGN_BASE_URL
+func NewClientFromEnv() (*Client, error) {
+	apiKey := strings.TrimSpace(os.Getenv(APIKeyEnv))
+	if apiKey == "" {
+		return nil, fmt.Errorf("%s is not set", APIKeyEnv)
+	}
+
+	client := NewClient(apiKey)
+	if envURL := strings.TrimSpace(os.Getenv(BaseURLEnv)); envURL != "" {
+		// Paths are appended to the base URL
+		if !strings.HasSuffix(envURL, "/") {
+			envURL += "/"
+		}
+		client.BaseURL = envURL
+	}
+	return client, nil
+}
+
+// NewClientWithHTTPClient creates a Client for the production API that sends requests with hc
+func NewClientWithHTTPClient(apiKey string, hc *http.Client) *Client {
+	if hc == nil {
+		// A typed nil would otherwise hide the default http.Client
+		return NewClientWithDoer(apiKey, nil)
+	}
+	return NewClientWithDoer(apiKey, hc)
+}
+
+// NewClientWithDoer creates a Client for the production API that sends requests with doer, eg: a stub that returns canned responses
+func NewClientWithDoer(apiKey string, doer Doer) *Client {
+	return &Client{
+		APIKey:     apiKey,
+		BaseURL:    baseURL,
+		HTTPClient: doer,
+	}
 }
 
 // CreateEmbeddedSignatureRequest creates a new embedded signature
@@ -47,6 +142,72 @@ func (m *Client) CreateEmbeddedSignatureRequest(embeddedRequest model.EmbeddedSi
 	return m.parseSignatureRequestResponse(response)
 }
 
+// CreateEmbeddedSignatureRequestRaw - Creates a new embedded SignatureRequest and also returns the HTTP response, as GetSignatureRequestRaw does.
+func (m *Client) CreateEmbeddedSignatureRequestRaw(embeddedRequest model.EmbeddedSignatureRequest) (*model.SignatureRequest, *http.Response, error) {
+	params, writer, err := m.marshalMultipartEmbeddedSignatureRequest(embeddedRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, err := m.post("signature_request/create_embedded", params, *writer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return m.parseSignatureRequestResponseRaw(response)
+}
+
+// MarshalEmbeddedSignatureRequest - Returns the Content-Type and multipart body CreateEmbeddedSignatureRequest would send, without sending it.
+func (m *Client) MarshalEmbeddedSignatureRequest(embeddedRequest model.EmbeddedSignatureRequest) (string, []byte, error) {
+	params, writer, err := m.marshalMultipartEmbeddedSignatureRequest(embeddedRequest)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return writer.FormDataContentType(), params.Bytes(), nil
+}
+
+// CreateEmbeddedSignatureRequestIdempotent - Creates an embedded SignatureRequest once per key.
+// Retrying with the same key returns the recorded SignatureRequest instead of creating a duplicate.
+// Keys are scoped to the client's APIKey or AccessToken, and concurrent calls with the same key wait for the first to finish.
+func (m *Client) CreateEmbeddedSignatureRequestIdempotent(key string, embeddedRequest model.EmbeddedSignatureRequest) (*model.SignatureRequest, error) {
+	if key == "" {
+		return nil, fmt.Errorf("invalid argument: %s", key)
+	}
+
+	key = m.idempotencyKey(key)
+	unlock := idempotencyLocks.Lock(key)
+	defer unlock()
+
+	store := m.getIdempotencyStore()
+	if signatureRequest, ok := store.Get(key); ok {
+		return signatureRequest, nil
+	}
+
+	signatureRequest, err := m.CreateEmbeddedSignatureRequest(embeddedRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	store.Set(key, signatureRequest)
+	return signatureRequest, nil
+}
+
+// CreateSignatureRequest creates a new signature request which HelloSign emails to the signers directly
+func (m *Client) CreateSignatureRequest(req model.SignatureRequestSendRequest) (*model.SignatureRequest, error) {
+	params, writer, err := m.marshalMultipartSignatureRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post("signature_request/send", params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseSignatureRequestResponse(response)
+}
+
 // CreateEmbeddedSignatureWithTemplateRequest creates a new embedded signature with template id
 func (m *Client) CreateEmbeddedSignatureWithTemplateRequest(embeddedRequest model.EmbeddedSignatureWithTemplateRequest, signerRoles []model.SignerRole) (*model.SignatureRequest, error) {
 	params, writer, err := m.marshalMultipartEmbeddedSignatureWithTemplateRequest(embeddedRequest, signerRoles)
@@ -62,23 +223,131 @@ func (m *Client) CreateEmbeddedSignatureWithTemplateRequest(embeddedRequest mode
 	return m.parseSignatureRequestResponse(response)
 }
 
+// CreateSignatureRequestWithTemplate creates a new signature request from one or more templates which HelloSign emails to the signers directly
+func (m *Client) CreateSignatureRequestWithTemplate(req model.SignatureRequestSendWithTemplateRequest, signerRoles []model.SignerRole) (*model.SignatureRequest, error) {
+	params, writer, err := m.marshalMultipartSignatureWithTemplateRequest(req, signerRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post("signature_request/send_with_template", params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseSignatureRequestResponse(response)
+}
+
 // GetSignatureRequest - Gets a SignatureRequest that includes the current status for each signer.
 func (m *Client) GetSignatureRequest(signatureRequestID string) (*model.SignatureRequest, error) {
+	signatureRequest, _, err := m.GetSignatureRequestCached(signatureRequestID)
+	return signatureRequest, err
+}
+
+// GetSignatureRequestCached - Gets a SignatureRequest, reporting whether it came from the client's SignatureRequestCache.
+// Only completed requests are cached, so incomplete ones are always fetched again.
+func (m *Client) GetSignatureRequestCached(signatureRequestID string) (*model.SignatureRequest, bool, error) {
+	if m.SignatureRequestCache != nil {
+		if signatureRequest, ok := m.SignatureRequestCache.Get(signatureRequestID); ok {
+			return signatureRequest, true, nil
+		}
+	}
+
+	path := fmt.Sprintf("signature_request/%s", signatureRequestID)
+	response, err := m.get(path)
+	if err != nil {
+		return nil, false, err
+	}
+	signatureRequest, err := m.parseSignatureRequestResponse(response)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if m.SignatureRequestCache != nil {
+		m.SignatureRequestCache.Add(signatureRequest)
+	}
+	return signatureRequest, false, nil
+}
+
+// GetSignatureRequestRaw - Gets a SignatureRequest along with the HTTP response it was parsed from.
+// The body has already been read and closed; response.Body holds a copy that can be read again without leaking the connection.
+func (m *Client) GetSignatureRequestRaw(signatureRequestID string) (*model.SignatureRequest, *http.Response, error) {
 	path := fmt.Sprintf("signature_request/%s", signatureRequestID)
 	response, err := m.get(path)
 	if err != nil {
+		return nil, nil, err
+	}
+	return m.parseSignatureRequestResponseRaw(response)
+}
+
+// WaitForCompletion - Polls GetSignatureRequest every interval until the request is complete.
+// A declined request is returned together with ErrSignatureRequestDeclined, and a cancelled context returns ctx.Err().
+// Each poll goes through the configured RetryPolicy, and when the rate limit is exhausted the next poll waits for the window to reset.
+// Polls are sent with ctx, so cancelling it also aborts the poll in flight.
+func (m *Client) WaitForCompletion(ctx context.Context, signatureRequestID string, interval time.Duration) (*model.SignatureRequest, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid argument: interval %s must be positive", interval)
+	}
+	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	return m.parseSignatureRequestResponse(response)
+
+	opts := m.requestOptions
+	opts.Context = ctx
+	client := m.WithRequestOptions(opts)
+
+	for {
+		signatureRequest, err := client.GetSignatureRequest(signatureRequestID)
+		if err != nil {
+			return nil, err
+		}
+		if signatureRequest.GetIsComplete() {
+			return signatureRequest, nil
+		}
+		if signatureRequest.GetIsDeclined() {
+			return signatureRequest, ErrSignatureRequestDeclined
+		}
+
+		timer := time.NewTimer(client.pollDelay(interval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// pollDelay – Returns interval, or the time until the rate limit resets when no requests remain in the window
+func (m *Client) pollDelay(interval time.Duration) time.Duration {
+	rateLimit := m.LastRateLimit()
+	if rateLimit == nil || rateLimit.GetRemaining() > 0 {
+		return interval
+	}
+	if untilReset := time.Until(rateLimit.GetResetTime()); untilReset > interval {
+		return untilReset
+	}
+	return interval
 }
 
 // GetEmbeddedSignURL - Retrieves an embedded signing object.
 func (m *Client) GetEmbeddedSignURL(signatureID string) (*model.SignURLResponse, error) {
 	path := fmt.Sprintf("embedded/sign_url/%s", signatureID)
 	response, err := m.get(path)
+	for attempt := 0; err != nil && m.isSignURLNotReady(err); attempt++ {
+		wait, ok := m.SignURLRetryPolicy.notReadyRetryAfter(attempt)
+		if !ok {
+			break
+		}
+		if err := m.sleepContext(m.getContext(), wait); err != nil {
+			return nil, err
+		}
+		response, err = m.get(path)
+	}
 	if err != nil {
 		return nil, err
 	}
+	defer response.Body.Close()
 
 	data := &model.EmbeddedSignatureResponse{}
 	err = json.NewDecoder(response.Body).Decode(data)
@@ -89,6 +358,60 @@ func (m *Client) GetEmbeddedSignURL(signatureID string) (*model.SignURLResponse,
 	return data.GetEmbedded(), nil
 }
 
+// isSignURLNotReady – Reports whether the sign URL failed because HelloSign is still provisioning the signature,
+// which it reports as a 404 or a 409 conflict
+func (m *Client) isSignURLNotReady(err error) bool {
+	apiErr, ok := err.(*model.APIError)
+	if !ok {
+		return false
+	}
+	return apiErr.GetStatusCode() == http.StatusNotFound || apiErr.GetStatusCode() == http.StatusConflict
+}
+
+// GetEmbeddedSignURLsForRequest - Retrieves the embedded sign URL of every signer of the signature request, keyed by signer email, eg: for kiosk signing.
+// When some of the URLs can't be retrieved the others are still returned, along with a *SignURLsError.
+func (m *Client) GetEmbeddedSignURLsForRequest(signatureRequestID string) (map[string]*model.SignURLResponse, error) {
+	signatureRequest, err := m.GetSignatureRequest(signatureRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	signURLs := make(map[string]*model.SignURLResponse)
+	failures := make(map[string]error)
+	for _, signature := range signatureRequest.GetSignatures() {
+		signURL, err := m.GetEmbeddedSignURL(signature.GetSignatureID())
+		if err != nil {
+			failures[signature.GetSignerEmailAddress()] = err
+			continue
+		}
+		signURLs[signature.GetSignerEmailAddress()] = signURL
+	}
+
+	if len(failures) > 0 {
+		return signURLs, &SignURLsError{Errors: failures}
+	}
+	return signURLs, nil
+}
+
+// SignURLsError is returned by GetEmbeddedSignURLsForRequest when the sign URL of some signers could not be retrieved
+type SignURLsError struct {
+	Errors map[string]error // The error for each signer email that failed.
+}
+
+func (e *SignURLsError) Error() string {
+	emails := make([]string, 0, len(e.Errors))
+	for email := range e.Errors {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	msgs := make([]string, len(emails))
+	for i, email := range emails {
+		msgs[i] = fmt.Sprintf("%s: %v", email, e.Errors[email])
+	}
+	return fmt.Sprintf("failed to get %d sign URLs: %s", len(emails), strings.Join(msgs, ", "))
+}
+
 func (m *Client) SaveFile(signatureRequestID, fileType, destFilePath string) (os.FileInfo, error) {
 	bytes, err := m.GetFiles(signatureRequestID, fileType)
 
@@ -111,46 +434,137 @@ func (m *Client) GetPDF(signatureRequestID string) ([]byte, error) {
 	return m.GetFiles(signatureRequestID, "pdf")
 }
 
+// GetCombinedPDF - Obtain the documents of the signature_request_id parameter merged into one pdf, ending with the audit trail certificate.
+// HelloSign only appends the audit trail to the merged "pdf" file type, and has no way to download the audit trail on its own.
+func (m *Client) GetCombinedPDF(signatureRequestID string) ([]byte, error) {
+	data, err := m.GetFiles(signatureRequestID, "pdf")
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, []byte("%PDF-")) {
+		return nil, fmt.Errorf("files of signature request %s are not a pdf", signatureRequestID)
+	}
+	return data, nil
+}
+
 // GetFiles - Obtain a copy of the current documents specified by the signature_request_id parameter.
 // signatureRequestID - The id of the SignatureRequest to retrieve.
 // fileType - Set to "pdf" for a single merged document or "zip" for a collection of individual documents.
 func (m *Client) GetFiles(signatureRequestID, fileType string) ([]byte, error) {
+	return m.getFiles(fmt.Sprintf("signature_request/files/%s", signatureRequestID), fileType, nil)
+}
+
+// DownloadFiles - Streams the current documents specified by the signature_request_id parameter into w,
+// returning the number of bytes written. Unlike GetFiles the documents are never held in memory.
+// fileType - Set to "pdf" for a single merged document or "zip" for a collection of individual documents.
+func (m *Client) DownloadFiles(signatureRequestID, fileType string, w io.Writer) (int64, error) {
 	path := fmt.Sprintf("signature_request/files/%s", signatureRequestID)
+	response, err := m.requestFiles(path, fileType, "get_url", "false")
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
 
-	var params bytes.Buffer
-	writer := multipart.NewWriter(&params)
+	return io.Copy(w, response.Body)
+}
 
-	signatureIDField, err := writer.CreateFormField("file_type")
-	if err != nil {
+// GetFilesURL - Obtain a temporary download link for the documents specified by the signature_request_id parameter.
+// signatureRequestID - The id of the SignatureRequest to retrieve.
+// fileType - Set to "pdf" for a single merged document or "zip" for a collection of individual documents.
+func (m *Client) GetFilesURL(signatureRequestID, fileType string) (*model.FileURLResponse, error) {
+	data := &model.FileURLResponse{}
+	if _, err := m.getFiles(fmt.Sprintf("signature_request/files/%s", signatureRequestID), fileType, data); err != nil {
 		return nil, err
 	}
-	signatureIDField.Write([]byte(fileType))
+	return data, nil
+}
+
+// GetFilesDataURI - Obtain the current pdf specified by the signature_request_id parameter as a base64 encoded data URI.
+func (m *Client) GetFilesDataURI(signatureRequestID string) (*model.FileDataURIResponse, error) {
+	data := &model.FileDataURIResponse{}
+	if _, err := m.getFiles(fmt.Sprintf("signature_request/files/%s", signatureRequestID), "pdf", data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
 
-	emailField, err := writer.CreateFormField("get_url")
+// GetAttachmentURL - Obtain a temporary download link for a file a signer uploaded as an attachment.
+// attachmentID - The id of the attachment, as returned in the SignatureRequest's attachments.
+func (m *Client) GetAttachmentURL(attachmentID string) (*model.FileURLResponse, error) {
+	if attachmentID == "" {
+		return nil, fmt.Errorf("invalid argument: %s", attachmentID)
+	}
+	path := fmt.Sprintf("attachment/%s/download", attachmentID)
+	response, err := m.getWithQuery(path, url.Values{"get_url": {"1"}})
 	if err != nil {
 		return nil, err
 	}
-	emailField.Write([]byte("false"))
+	defer response.Body.Close()
 
-	response, err := m.request("GET", path, &params, *writer)
+	data := &model.FileURLResponse{}
+	err = json.NewDecoder(response.Body).Decode(data)
 	if err != nil {
 		return nil, err
 	}
 
+	return data, nil
+}
+
+// getFiles – Fetches the files at path. With a Downloadable its form field is set and the JSON response decoded into it,
+// otherwise the files themselves are returned
+func (m *Client) getFiles(path, fileType string, into model.Downloadable) ([]byte, error) {
+	if into == nil {
+		response, err := m.requestFiles(path, fileType, "get_url", "false")
+		if err != nil {
+			return nil, err
+		}
+		defer response.Body.Close()
+
+		return ioutil.ReadAll(response.Body)
+	}
+
+	response, err := m.requestFiles(path, fileType, into.DownloadFormField(), "true")
+	if err != nil {
+		return nil, err
+	}
 	defer response.Body.Close()
 
-	data, err := ioutil.ReadAll(response.Body)
+	return nil, json.NewDecoder(response.Body).Decode(into)
+}
+
+// requestFiles - Requests the files endpoint at path with the given file_type and response option.
+func (m *Client) requestFiles(path, fileType, optionKey, optionValue string) (*http.Response, error) {
+	var params bytes.Buffer
+	writer, err := m.newMultipartWriter(&params)
 	if err != nil {
 		return nil, err
 	}
 
-	return data, nil
+	fileTypeField, err := writer.CreateFormField("file_type")
+	if err != nil {
+		return nil, err
+	}
+	fileTypeField.Write([]byte(fileType))
+
+	optionField, err := writer.CreateFormField(optionKey)
+	if err != nil {
+		return nil, err
+	}
+	optionField.Write([]byte(optionValue))
+	writer.Close()
+
+	return m.request("GET", path, &params, *writer)
 }
 
 // ListSignatureRequests - Lists the SignatureRequests (both inbound and outbound) that you have access to.
 func (m *Client) ListSignatureRequests() (*model.ListSignaturesResponse, error) {
-	path := fmt.Sprintf("signature_request/list")
-	response, err := m.get(path)
+	return m.ListSignatureRequestsWithParams(model.ListParams{})
+}
+
+// ListSignatureRequestsWithParams - Lists a page of the SignatureRequests that you have access to.
+// Zero values in params are omitted so HelloSign's defaults apply.
+func (m *Client) ListSignatureRequestsWithParams(params model.ListParams) (*model.ListSignaturesResponse, error) {
+	response, err := m.getWithQuery("signature_request/list", m.listQuery(params))
 	if err != nil {
 		return nil, err
 	}
@@ -166,12 +580,119 @@ func (m *Client) ListSignatureRequests() (*model.ListSignaturesResponse, error)
 	return listResponse, err
 }
 
+// SignatureRequestIterator walks every page of ListSignatureRequestsWithParams, fetching pages as they are consumed
+type SignatureRequestIterator struct {
+	client  *Client
+	params  model.ListParams
+	page    []*model.SignatureRequest
+	index   int
+	started bool
+	done    bool
+	err     error
+}
+
+// IterateSignatureRequests - Returns an iterator over the SignatureRequests matching params, starting at params.Page.
+func (m *Client) IterateSignatureRequests(params model.ListParams) *SignatureRequestIterator {
+	return &SignatureRequestIterator{client: m, params: params}
+}
+
+// Next returns the next SignatureRequest. The bool is false once every page has been consumed or a page fetch failed.
+func (it *SignatureRequestIterator) Next() (*model.SignatureRequest, bool, error) {
+	for it.index >= len(it.page) {
+		if it.err != nil {
+			return nil, false, it.err
+		}
+		if it.done {
+			return nil, false, nil
+		}
+		it.fetch()
+	}
+
+	sigRequest := it.page[it.index]
+	it.index++
+	return sigRequest, true, nil
+}
+
+func (it *SignatureRequestIterator) fetch() {
+	if it.started {
+		it.params.Page++
+	} else if it.params.Page == 0 {
+		it.params.Page = 1
+	}
+	it.started = true
+
+	res, err := it.client.ListSignatureRequestsWithParams(it.params)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.page = res.GetSignatureRequests()
+	it.index = 0
+
+	listInfo := res.GetListInfo()
+	if listInfo == nil || listInfo.GetPage() >= listInfo.GetNumPages() {
+		it.done = true
+	}
+}
+
+// FindSignatureRequestsByMetadata - Searches every page of SignatureRequests for those whose metadata has key set to value.
+// HelloSign's search is a full text match, so results are also checked for the exact value locally.
+func (m *Client) FindSignatureRequestsByMetadata(key, value string) ([]*model.SignatureRequest, error) {
+	it := m.IterateSignatureRequests(model.ListParams{Query: fmt.Sprintf("metadata:%s=%s", key, value)})
+
+	matches := []*model.SignatureRequest{}
+	for {
+		sigRequest, ok, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return matches, nil
+		}
+		if sigRequest.GetMetadata()[key] == value {
+			matches = append(matches, sigRequest)
+		}
+	}
+}
+
+// ListCompletedSignatureRequests - Returns the SignatureRequests completed between since and until, oldest first.
+// The search can only narrow requests by creation date, so completion times are checked locally.
+func (m *Client) ListCompletedSignatureRequests(since, until time.Time) ([]*model.SignatureRequest, error) {
+	query := fmt.Sprintf("complete:true AND created:[* TO %s]", until.UTC().Format("2006-01-02"))
+	it := m.IterateSignatureRequests(model.ListParams{Query: query})
+
+	completed := []*model.SignatureRequest{}
+	for {
+		sigRequest, ok, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		completedAt := sigRequest.GetCompletedAtTime()
+		if completedAt.IsZero() || completedAt.Before(since) || completedAt.After(until) {
+			continue
+		}
+		completed = append(completed, sigRequest)
+	}
+
+	sort.SliceStable(completed, func(i, j int) bool {
+		return completed[i].GetCompletedAtTime().Before(completed[j].GetCompletedAtTime())
+	})
+	return completed, nil
+}
+
 // UpdateSignatureRequest - Update an email address on a signature request.
 func (m *Client) UpdateSignatureRequest(signatureRequestID string, signatureID string, email string) (*model.SignatureRequest, error) {
 	path := fmt.Sprintf("signature_request/update/%s", signatureRequestID)
 
 	var params bytes.Buffer
-	writer := multipart.NewWriter(&params)
+	writer, err := m.newMultipartWriter(&params)
+	if err != nil {
+		return nil, err
+	}
 
 	signatureIDField, err := writer.CreateFormField("signature_id")
 	if err != nil {
@@ -193,8 +714,58 @@ func (m *Client) UpdateSignatureRequest(signatureRequestID string, signatureID s
 	return m.parseSignatureRequestResponse(response)
 }
 
+// RemindSignatureRequest - Sends an email to the signer reminding them to sign the signature request.
+func (m *Client) RemindSignatureRequest(signatureRequestID string, email string) (*model.SignatureRequest, error) {
+	return m.RemindSignatureRequestWithName(signatureRequestID, email, "")
+}
+
+// RemindSignatureRequestWithName - Sends a reminder to the signer identified by email and name.
+// name is only required when several signers on the request share the same email address.
+func (m *Client) RemindSignatureRequestWithName(signatureRequestID string, email string, name string) (*model.SignatureRequest, error) {
+	path := fmt.Sprintf("signature_request/remind/%s", signatureRequestID)
+
+	var params bytes.Buffer
+	writer, err := m.newMultipartWriter(&params)
+	if err != nil {
+		return nil, err
+	}
+
+	emailField, err := writer.CreateFormField("email_address")
+	if err != nil {
+		return nil, err
+	}
+	emailField.Write([]byte(email))
+
+	if name != "" {
+		nameField, err := writer.CreateFormField("name")
+		if err != nil {
+			return nil, err
+		}
+		nameField.Write([]byte(name))
+	}
+	writer.Close()
+
+	response, err := m.post(path, &params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseSignatureRequestResponse(response)
+}
+
 // CancelSignatureRequest - Cancels an incomplete signature request. This action is not reversible.
-func (m *Client) CancelSignatureRequest(signatureRequestID string) (*http.Response, error) {
+func (m *Client) CancelSignatureRequest(signatureRequestID string) (*model.OperationResult, error) {
+	response, err := m.CancelSignatureRequestRaw(signatureRequestID)
+	if err != nil {
+		return nil, err
+	}
+	return m.parseOperationResult(response)
+}
+
+// CancelSignatureRequestRaw - Cancels an incomplete signature request, returning the unchecked response. The caller must close the body.
+//
+// Deprecated: use CancelSignatureRequest, which checks the status and closes the body.
+func (m *Client) CancelSignatureRequestRaw(signatureRequestID string) (*http.Response, error) {
 	path := fmt.Sprintf("signature_request/cancel/%s", signatureRequestID)
 
 	response, err := m.nakedPost(path)
@@ -205,20 +776,136 @@ func (m *Client) CancelSignatureRequest(signatureRequestID string) (*http.Respon
 	return response, err
 }
 
+// TryCancelSignatureRequest - Cancels the signature request unless it is already complete.
+// It returns false, without attempting the cancel, when the request is complete, and also when the cancel is rejected
+// because the request was completed or cancelled in the meantime. Any other rejection is returned as a *model.APIError.
+func (m *Client) TryCancelSignatureRequest(signatureRequestID string) (bool, error) {
+	signatureRequest, err := m.GetSignatureRequest(signatureRequestID)
+	if err != nil {
+		return false, err
+	}
+	if signatureRequest.GetIsComplete() {
+		return false, nil
+	}
+
+	if _, err := m.CancelSignatureRequest(signatureRequestID); err != nil {
+		if m.isAlreadyFinished(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isAlreadyFinished – Reports whether the cancel failed because the signature request is already complete or cancelled,
+// which HelloSign reports as a 409 conflict
+func (m *Client) isAlreadyFinished(err error) bool {
+	apiErr, ok := err.(*model.APIError)
+	if !ok {
+		return false
+	}
+	return apiErr.GetStatusCode() == http.StatusConflict || apiErr.GetErrorName() == "conflict"
+}
+
+// CancelSignatureRequests - Cancels the signature requests in parallel, with at most concurrency cancels in flight.
+// Every id is in the returned map, with a nil error when it was cancelled. Once ctx is done the cancels in flight are aborted,
+// no further cancels are started, the ids never attempted get ctx.Err() and ctx.Err() is also returned. Each cancel goes through the configured RetryPolicy.
+func (m *Client) CancelSignatureRequests(ctx context.Context, signatureRequestIDs []string, concurrency int) (map[string]error, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make(map[string]error, len(signatureRequestIDs))
+	var mu sync.Mutex
+	record := func(id string, err error) {
+		mu.Lock()
+		results[id] = err
+		mu.Unlock()
+	}
+
+	// Cancels in flight are sent with ctx, so cancelling it aborts them too
+	opts := m.requestOptions
+	opts.Context = ctx
+	client := m.WithRequestOptions(opts)
+
+	ids := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				if err := ctx.Err(); err != nil {
+					record(id, err)
+					continue
+				}
+				_, err := client.CancelSignatureRequest(id)
+				record(id, err)
+			}
+		}()
+	}
+
+	for i, id := range signatureRequestIDs {
+		if ctx.Err() == nil {
+			select {
+			case ids <- id:
+				continue
+			case <-ctx.Done():
+			}
+		}
+		for _, skipped := range signatureRequestIDs[i:] {
+			record(skipped, ctx.Err())
+		}
+		break
+	}
+	close(ids)
+	wg.Wait()
+
+	return results, ctx.Err()
+}
+
 // DeleteSignatureRequest - Remove access to a completed SignatureRequest. This action is not reversible.
-func (m *Client) DeleteSignatureRequest(signatureRequestID string) (*http.Response, error) {
+func (m *Client) DeleteSignatureRequest(signatureRequestID string) (*model.OperationResult, error) {
+	response, err := m.DeleteSignatureRequestRaw(signatureRequestID)
+	if err != nil {
+		return nil, err
+	}
+	return m.parseOperationResult(response)
+}
+
+// DeleteSignatureRequestRaw - Remove access to a completed SignatureRequest, returning the unchecked response. The caller must close the body.
+//
+// Deprecated: use DeleteSignatureRequest, which checks the status and closes the body.
+func (m *Client) DeleteSignatureRequestRaw(signatureRequestID string) (*http.Response, error) {
 	return m.nakedPost(fmt.Sprintf("signature_request/remove/%s", signatureRequestID))
 }
 
 // Private Methods
 
 func (m *Client) marshalMultipartEmbeddedSignatureRequest(embRequest model.EmbeddedSignatureRequest) (*bytes.Buffer, *multipart.Writer, error) {
+	return m.marshalMultipartSignatureRequest(embRequest)
+}
+
+// marshalMultipartSignatureRequest – Marshals any of the non-template signature request models by reading their form_field tags
+func (m *Client) marshalMultipartSignatureRequest(request interface{}) (*bytes.Buffer, *multipart.Writer, error) {
+	if err := m.validateFileSources(request); err != nil {
+		return nil, nil, err
+	}
+	if err := m.validateTextTags(request); err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	w, err := m.newMultipartWriter(&b)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
+	structType := reflect.TypeOf(request)
+	val := reflect.ValueOf(request)
 
-	structType := reflect.TypeOf(embRequest)
-	val := reflect.ValueOf(embRequest)
+	// file[] indexes continue across File and FileUploads
+	fileIndex := 0
 
 	for i := 0; i < val.NumField(); i++ {
 
@@ -226,21 +913,31 @@ func (m *Client) marshalMultipartEmbeddedSignatureRequest(embRequest model.Embed
 		f := valueField.Interface()
 		val := reflect.ValueOf(f)
 		field := structType.Field(i)
-		fieldTag := field.Tag.Get(FormFieldKey)
+		fieldTag, omitFalse := m.formFieldTag(field)
 
 		switch val.Kind() {
 		case reflect.Map:
-			for k, v := range embRequest.GetMetadata() {
-				formField, err := w.CreateFormField(fmt.Sprintf("metadata[%v]", k))
-				if err != nil {
-					return nil, nil, err
+			if fieldTag == MetadataKey {
+				values := f.(map[string]string)
+				for _, k := range m.sortedKeys(values) {
+					v := values[k]
+					formField, err := w.CreateFormField(fmt.Sprintf("metadata[%v]", k))
+					if err != nil {
+						return nil, nil, err
+					}
+					formField.Write([]byte(v))
 				}
-				formField.Write([]byte(v))
 			}
 		case reflect.Slice:
 			switch fieldTag {
 			case SignersKey:
-				for i, signer := range embRequest.GetSigners() {
+				signers := f.([]model.Signer)
+				ordered, err := m.validateSignerOrder(signers)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				for i, signer := range signers {
 					email, err := w.CreateFormField(fmt.Sprintf("%s[%v][email_address]", SignersKey, i))
 					if err != nil {
 						return nil, nil, err
@@ -253,7 +950,7 @@ func (m *Client) marshalMultipartEmbeddedSignatureRequest(embRequest model.Embed
 					}
 					name.Write([]byte(signer.GetName()))
 
-					if signer.Order != 0 {
+					if ordered {
 						order, err := w.CreateFormField(fmt.Sprintf("%s[%v][order]", SignersKey, i))
 						if err != nil {
 							return nil, nil, err
@@ -268,9 +965,17 @@ func (m *Client) marshalMultipartEmbeddedSignatureRequest(embRequest model.Embed
 						}
 						pin.Write([]byte(signer.GetPin()))
 					}
+
+					if err := m.writeSignerSMS(w, fmt.Sprintf("%s[%v]", SignersKey, i), signer); err != nil {
+						return nil, nil, err
+					}
+
+					if err := m.writeSignerLanguage(w, fmt.Sprintf("%s[%v]", SignersKey, i), signer); err != nil {
+						return nil, nil, err
+					}
 				}
 			case CCEmailAddressesKey:
-				for k, v := range embRequest.GetCCEmailAddresses() {
+				for k, v := range f.([]string) {
 					formField, err := w.CreateFormField(fmt.Sprintf("cc_email_addresses[%v]", k))
 					if err != nil {
 						return nil, nil, err
@@ -278,29 +983,54 @@ func (m *Client) marshalMultipartEmbeddedSignatureRequest(embRequest model.Embed
 					formField.Write([]byte(v))
 				}
 			case FormFieldsPerDocKey:
-				if len(embRequest.GetFormFieldsPerDocument()) > 0 {
+				formFields := f.([][]model.DocumentFormField)
+				if len(formFields) > 0 {
 					formField, err := w.CreateFormField(fieldTag)
 					if err != nil {
 						return nil, nil, err
 					}
-					ffpdJSON, err := json.Marshal(embRequest.GetFormFieldsPerDocument())
+					ffpdJSON, err := json.Marshal(formFields)
 					if err != nil {
 						return nil, nil, err
 					}
 					formField.Write([]byte(ffpdJSON))
 				}
-			case FileKey:
-				for i, path := range embRequest.GetFile() {
-					file, _ := os.Open(path)
-
-					formField, err := w.CreateFormFile(fmt.Sprintf("%s[%v]", FileKey, i), file.Name())
+			case CustomFieldsKey:
+				customFields := f.([]model.CustomField)
+				if len(customFields) > 0 {
+					formField, err := w.CreateFormField(fieldTag)
+					if err != nil {
+						return nil, nil, err
+					}
+					cfJSON, err := json.Marshal(m.customFieldValues(customFields))
 					if err != nil {
 						return nil, nil, err
 					}
-					_, err = io.Copy(formField, file)
+					formField.Write(cfJSON)
+				}
+			case AttachmentsKey:
+				if err := m.writeAttachments(w, f.([]model.Attachment)); err != nil {
+					return nil, nil, err
+				}
+			case FileKey:
+				switch files := f.(type) {
+				case []string:
+					for _, path := range files {
+						if err := m.writeFilePath(w, fmt.Sprintf("%s[%v]", FileKey, fileIndex), path); err != nil {
+							return nil, nil, err
+						}
+						fileIndex++
+					}
+				case []model.FileUpload:
+					for _, upload := range files {
+						if err := m.writeFileUpload(w, fmt.Sprintf("%s[%v]", FileKey, fileIndex), upload); err != nil {
+							return nil, nil, err
+						}
+						fileIndex++
+					}
 				}
 			case FileURLKey:
-				for i, fileURL := range embRequest.GetFileURL() {
+				for i, fileURL := range f.([]string) {
 					formField, err := w.CreateFormField(fmt.Sprintf("%s[%v]", FileURLKey, i))
 					if err != nil {
 						return nil, nil, err
@@ -308,12 +1038,23 @@ func (m *Client) marshalMultipartEmbeddedSignatureRequest(embRequest model.Embed
 					formField.Write([]byte(fileURL))
 				}
 			}
+		case reflect.Ptr:
+			if err := m.writePointerField(w, fieldTag, val); err != nil {
+				return nil, nil, err
+			}
 		case reflect.Bool:
+			if omitFalse && !val.Bool() {
+				continue
+			}
 			formField, err := w.CreateFormField(fieldTag)
 			if err != nil {
 				return nil, nil, err
 			}
-			formField.Write([]byte(m.boolToIntString(val.Bool())))
+			formField.Write([]byte(m.boolFieldValue(fieldTag, val.Bool())))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if err := m.writeIntField(w, fieldTag, val.Int()); err != nil {
+				return nil, nil, err
+			}
 		default:
 			if val.String() != "" {
 				formField, err := w.CreateFormField(fieldTag)
@@ -330,12 +1071,20 @@ func (m *Client) marshalMultipartEmbeddedSignatureRequest(embRequest model.Embed
 }
 
 func (m *Client) marshalMultipartEmbeddedSignatureWithTemplateRequest(embRequest model.EmbeddedSignatureWithTemplateRequest, signerRoles []model.SignerRole) (*bytes.Buffer, *multipart.Writer, error) {
+	return m.marshalMultipartSignatureWithTemplateRequest(embRequest, signerRoles)
+}
+
+// marshalMultipartSignatureWithTemplateRequest – Marshals any of the template signature request models by reading their form_field tags
+func (m *Client) marshalMultipartSignatureWithTemplateRequest(request interface{}, signerRoles []model.SignerRole) (*bytes.Buffer, *multipart.Writer, error) {
 
 	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
+	w, err := m.newMultipartWriter(&b)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	structType := reflect.TypeOf(embRequest)
-	val := reflect.ValueOf(embRequest)
+	structType := reflect.TypeOf(request)
+	val := reflect.ValueOf(request)
 
 	for i := 0; i < val.NumField(); i++ {
 
@@ -343,24 +1092,25 @@ func (m *Client) marshalMultipartEmbeddedSignatureWithTemplateRequest(embRequest
 		f := valueField.Interface()
 		val := reflect.ValueOf(f)
 		field := structType.Field(i)
-		fieldTag := field.Tag.Get(FormFieldKey)
+		fieldTag, omitFalse := m.formFieldTag(field)
 
 		switch val.Kind() {
 		case reflect.Map:
-			if fieldTag == MetadataKey {
-				for k, v := range embRequest.GetMetadata() {
-					formField, err := w.CreateFormField(fmt.Sprintf("metadata[%v]", k))
-					if err != nil {
-						return nil, nil, err
-					}
-					formField.Write([]byte(v))
+			// metadata and pre-filled custom_fields are both sent as tag[key]=value
+			values := f.(map[string]string)
+			for _, k := range m.sortedKeys(values) {
+				v := values[k]
+				formField, err := w.CreateFormField(fmt.Sprintf("%s[%v]", fieldTag, k))
+				if err != nil {
+					return nil, nil, err
 				}
+				formField.Write([]byte(v))
 			}
 		case reflect.Slice:
 			switch fieldTag {
-			case "signers":
-				signers := embRequest.GetSigners()
-				if len(signerRoles) != len(embRequest.GetSigners()) {
+			case SignersKey:
+				signers := f.([]model.Signer)
+				if len(signerRoles) != len(signers) {
 					return nil, nil, fmt.Errorf("the number of signers and roles must match. [SignerRoles: %d, Signers: %d]", len(signerRoles), len(signers))
 				}
 
@@ -379,28 +1129,60 @@ func (m *Client) marshalMultipartEmbeddedSignatureWithTemplateRequest(embRequest
 					name.Write([]byte(signer.GetName()))
 
 					if signer.Pin != "" {
-						pin, err := w.CreateFormField(fmt.Sprintf("signers[%v][pin]", i))
+						pin, err := w.CreateFormField(fmt.Sprintf("signers[%v][pin]", roleName))
 						if err != nil {
 							return nil, nil, err
 						}
 						pin.Write([]byte(signer.GetPin()))
 					}
+
+					if err := m.writeSignerSMS(w, fmt.Sprintf("%s[%v]", SignersKey, roleName), signer); err != nil {
+						return nil, nil, err
+					}
+
+					if err := m.writeSignerLanguage(w, fmt.Sprintf("%s[%v]", SignersKey, roleName), signer); err != nil {
+						return nil, nil, err
+					}
+				}
+			case TemplateIDsKey:
+				for i, templateID := range f.([]string) {
+					formField, err := w.CreateFormField(fmt.Sprintf("%s[%v]", TemplateIDsKey, i))
+					if err != nil {
+						return nil, nil, err
+					}
+					formField.Write([]byte(templateID))
+				}
+			case CCsKey:
+				for _, cc := range f.([]model.CCRole) {
+					formField, err := w.CreateFormField(fmt.Sprintf("%s[%v][email_address]", CCsKey, cc.GetName()))
+					if err != nil {
+						return nil, nil, err
+					}
+					formField.Write([]byte(cc.GetEmail()))
 				}
 			case CCEmailAddressesKey:
-				for k, v := range embRequest.GetCCEmailAddresses() {
+				for k, v := range f.([]string) {
 					formField, err := w.CreateFormField(fmt.Sprintf("cc_email_addresses[%v]", k))
 					if err != nil {
 						return nil, nil, err
 					}
 					formField.Write([]byte(v))
 				}
-			case CustomFieldsKey:
-				customFields := make(map[string]string)
-				for _, cf := range embRequest.GetCustomFields() {
-					customFields[cf.GetName()] = fmt.Sprintf("%v", cf.GetValue())
+			case FormFieldsPerDocKey:
+				formFields := f.([][]model.DocumentFormField)
+				if len(formFields) > 0 {
+					formField, err := w.CreateFormField(fieldTag)
+					if err != nil {
+						return nil, nil, err
+					}
+					ffpdJSON, err := json.Marshal(formFields)
+					if err != nil {
+						return nil, nil, err
+					}
+					formField.Write([]byte(ffpdJSON))
 				}
-
-				cfByte, err := json.Marshal(customFields)
+			case CustomFieldsKey:
+				cfByte, err := m.templateCustomFieldsJSON(f.([]model.CustomField))
 				if err != nil {
 					return nil, nil, err
 				}
@@ -411,16 +1193,48 @@ func (m *Client) marshalMultipartEmbeddedSignatureWithTemplateRequest(embRequest
 				}
 
 				formField.Write(cfByte)
+			case AttachmentsKey:
+				if err := m.writeAttachments(w, f.([]model.Attachment)); err != nil {
+					return nil, nil, err
+				}
+			case SignerListKey:
+				signerList := f.([]model.BulkSignerList)
+				if len(signerList) > 0 {
+					formField, err := w.CreateFormField(SignerListKey)
+					if err != nil {
+						return nil, nil, err
+					}
+					slJSON, err := json.Marshal(signerList)
+					if err != nil {
+						return nil, nil, err
+					}
+					formField.Write(slJSON)
+				}
 			}
 
+		case reflect.Ptr:
+			if err := m.writePointerField(w, fieldTag, val); err != nil {
+				return nil, nil, err
+			}
 		case reflect.Bool:
+			if omitFalse && !val.Bool() {
+				continue
+			}
 			formField, err := w.CreateFormField(fieldTag)
 			if err != nil {
 				return nil, nil, err
 			}
-			formField.Write([]byte(m.boolToIntString(val.Bool())))
+			formField.Write([]byte(m.boolFieldValue(fieldTag, val.Bool())))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if err := m.writeIntField(w, fieldTag, val.Int()); err != nil {
+				return nil, nil, err
+			}
 		default:
-			if val.String() != "" {
+			if val.String() != "" && fieldTag == SignerFileKey {
+				if err := m.writeFilePath(w, fieldTag, val.String()); err != nil {
+					return nil, nil, err
+				}
+			} else if val.String() != "" {
 				formField, err := w.CreateFormField(fieldTag)
 				if err != nil {
 					return nil, nil, err
@@ -434,6 +1248,355 @@ func (m *Client) marshalMultipartEmbeddedSignatureWithTemplateRequest(embRequest
 	return &b, w, nil
 }
 
+// customFieldValue is a pre-filled custom field as sent with a non-template request, or a template request that locks fields
+type customFieldValue struct {
+	Name     string      `json:"name"`
+	Value    interface{} `json:"value"`
+	Editor   *string     `json:"editor,omitempty"`
+	Required bool        `json:"required"`
+}
+
+// customFieldValues – Converts custom fields into the JSON array expected by the non-template requests
+func (m *Client) customFieldValues(customFields []model.CustomField) []customFieldValue {
+	values := make([]customFieldValue, len(customFields))
+	for i, cf := range customFields {
+		values[i] = customFieldValue{
+			Name:     cf.GetName(),
+			Value:    cf.GetValue(),
+			Editor:   cf.GetEditor(),
+			Required: cf.GetRequired(),
+		}
+	}
+	return values
+}
+
+// templateCustomFieldsJSON – Encodes template custom fields as a name to value map, or as the array form
+// when any field sets an editor or is required, since the map has nowhere to put them
+func (m *Client) templateCustomFieldsJSON(customFields []model.CustomField) ([]byte, error) {
+	for _, cf := range customFields {
+		if cf.GetEditor() != nil || cf.GetRequired() {
+			return json.Marshal(m.customFieldValues(customFields))
+		}
+	}
+
+	values := make(map[string]string)
+	for _, cf := range customFields {
+		values[cf.GetName()] = fmt.Sprintf("%v", cf.GetValue())
+	}
+	return json.Marshal(values)
+}
+
+// writeIntField – Writes a non-zero int field, expires_at is checked by writeExpiresAt
+func (m *Client) writeIntField(w *multipart.Writer, fieldTag string, value int64) error {
+	if fieldTag == ExpiresAtKey {
+		return m.writeExpiresAt(w, fieldTag, value)
+	}
+	if value == 0 {
+		return nil
+	}
+
+	formField, err := w.CreateFormField(fieldTag)
+	if err != nil {
+		return err
+	}
+	formField.Write([]byte(strconv.FormatInt(value, 10)))
+	return nil
+}
+
+// writePointerField – Writes a non-nil pointer field. Struct pointers are written as options, anything else is written
+// as the value it points to, even a zero value, since setting the pointer is how a caller asks for it to be sent
+func (m *Client) writePointerField(w *multipart.Writer, fieldTag string, val reflect.Value) error {
+	if val.IsNil() {
+		return nil
+	}
+
+	elem := val.Elem()
+	var value string
+	switch elem.Kind() {
+	case reflect.Struct:
+		return m.writeOptions(w, fieldTag, val)
+	case reflect.Bool:
+		value = m.boolFieldValue(fieldTag, elem.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if fieldTag == ExpiresAtKey {
+			return m.writeExpiresAt(w, fieldTag, elem.Int())
+		}
+		value = strconv.FormatInt(elem.Int(), 10)
+	case reflect.String:
+		value = elem.String()
+	default:
+		return fmt.Errorf("unsupported pointer field %s: %s", fieldTag, val.Type())
+	}
+
+	formField, err := w.CreateFormField(fieldTag)
+	if err != nil {
+		return err
+	}
+	formField.Write([]byte(value))
+	return nil
+}
+
+// writeOptions – Writes an options struct such as signing_options as tag[field], skipping it entirely when nil.
+// Options with a Validate method are rejected before anything is written.
+func (m *Client) writeOptions(w *multipart.Writer, fieldTag string, options reflect.Value) error {
+	if options.IsNil() {
+		return nil
+	}
+
+	if v, ok := options.Interface().(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+
+	val := options.Elem()
+	for i := 0; i < val.NumField(); i++ {
+		name := fmt.Sprintf("%s[%s]", fieldTag, val.Type().Field(i).Tag.Get("field"))
+		field := val.Field(i)
+
+		switch field.Kind() {
+		case reflect.Bool:
+			formField, err := w.CreateFormField(name)
+			if err != nil {
+				return err
+			}
+			formField.Write([]byte(m.boolToIntString(field.Bool())))
+		default:
+			if field.String() != "" {
+				formField, err := w.CreateFormField(name)
+				if err != nil {
+					return err
+				}
+				formField.Write([]byte(field.String()))
+			}
+		}
+	}
+	return nil
+}
+
+// validateFileSources – Rejects a request that sets both file and file_url, which HelloSign treats as mutually exclusive,
+// or whose file_url entries are not absolute http(s) URLs
+func (m *Client) validateFileSources(request interface{}) error {
+	hasFile, hasFileURL := false, false
+
+	structType := reflect.TypeOf(request)
+	val := reflect.ValueOf(request)
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		if field.Kind() != reflect.Slice || field.Len() == 0 {
+			continue
+		}
+		switch structType.Field(i).Tag.Get(FormFieldKey) {
+		case FileKey:
+			hasFile = true
+		case FileURLKey:
+			hasFileURL = true
+			if fileURLs, ok := field.Interface().([]string); ok {
+				if err := m.validateFileURLs(fileURLs); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if hasFile && hasFileURL {
+		return errors.New("file and file_url cannot be used together, provide the documents as either files or file URLs")
+	}
+	return nil
+}
+
+// validateTextTags – Rejects hide_text_tags without use_text_tags, which HelloSign silently ignores, leaving the tags visible
+func (m *Client) validateTextTags(request interface{}) error {
+	useTextTags, hideTextTags := false, false
+
+	structType := reflect.TypeOf(request)
+	val := reflect.ValueOf(request)
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		if field.Kind() != reflect.Bool {
+			continue
+		}
+		switch structType.Field(i).Tag.Get(FormFieldKey) {
+		case UseTextTagsKey:
+			useTextTags = field.Bool()
+		case HideTextTagsKey:
+			hideTextTags = field.Bool()
+		}
+	}
+
+	if hideTextTags && !useTextTags {
+		return errors.New("hide_text_tags requires use_text_tags, HelloSign only hides text tags it is parsing")
+	}
+	return nil
+}
+
+// formFieldTag – Returns the form field name from the field's form_field tag, and whether the tag's omitfalse option
+// asks for a false bool to be left out of the form instead of being sent as 0
+func (m *Client) formFieldTag(field reflect.StructField) (string, bool) {
+	tag := strings.Split(field.Tag.Get(FormFieldKey), ",")
+	for _, option := range tag[1:] {
+		if option == "omitfalse" {
+			return tag[0], true
+		}
+	}
+	return tag[0], false
+}
+
+// sortedKeys – Returns the keys of values in order, so maps are always marshalled the same way
+func (m *Client) sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateFileURLs – Returns an error naming the first file_url entry that is not an absolute http(s) URL
+func (m *Client) validateFileURLs(fileURLs []string) error {
+	for i, fileURL := range fileURLs {
+		u, err := url.Parse(fileURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s[%d] is not an absolute http(s) URL: %q", FileURLKey, i, fileURL)
+		}
+	}
+	return nil
+}
+
+// validateSignerOrder – Reports whether the signers are ordered, which is when any of them sets Order.
+// Ordered signers must all have distinct, non-negative orders, as HelloSign otherwise rejects the request with an opaque error.
+func (m *Client) validateSignerOrder(signers []model.Signer) (bool, error) {
+	ordered := false
+	for _, signer := range signers {
+		if signer.GetOrder() != 0 {
+			ordered = true
+			break
+		}
+	}
+	if !ordered {
+		return false, nil
+	}
+
+	seen := make(map[int]int, len(signers))
+	for i, signer := range signers {
+		order := signer.GetOrder()
+		if order < 0 {
+			return false, fmt.Errorf("%s[%v] has a negative order: %d", SignersKey, i, order)
+		}
+		if j, ok := seen[order]; ok {
+			return false, fmt.Errorf("%s[%v] and %s[%v] have the same order: %d", SignersKey, j, SignersKey, i, order)
+		}
+		seen[order] = i
+	}
+	return true, nil
+}
+
+// writeSignerSMS – Writes the signer's sms_phone_number fields under prefix when a number is set
+func (m *Client) writeSignerSMS(w *multipart.Writer, prefix string, signer model.Signer) error {
+	if signer.SMSPhoneNumber == "" {
+		return nil
+	}
+
+	number, err := w.CreateFormField(fmt.Sprintf("%s[sms_phone_number]", prefix))
+	if err != nil {
+		return err
+	}
+	number.Write([]byte(signer.GetSMSPhoneNumber()))
+
+	if signer.SMSPhoneNumberType != "" {
+		numberType, err := w.CreateFormField(fmt.Sprintf("%s[sms_phone_number_type]", prefix))
+		if err != nil {
+			return err
+		}
+		numberType.Write([]byte(signer.GetSMSPhoneNumberType()))
+	}
+	return nil
+}
+
+// writeSignerLanguage – Writes the signer's language under prefix when set, rejecting languages HelloSign doesn't support
+func (m *Client) writeSignerLanguage(w *multipart.Writer, prefix string, signer model.Signer) error {
+	if signer.Language == "" {
+		return nil
+	}
+	if err := model.ValidateLanguage(signer.GetLanguage()); err != nil {
+		return err
+	}
+
+	language, err := w.CreateFormField(fmt.Sprintf("%s[language]", prefix))
+	if err != nil {
+		return err
+	}
+	language.Write([]byte(signer.GetLanguage()))
+	return nil
+}
+
+// writeExpiresAt – Writes expires_at when set, rejecting a time that has already passed
+func (m *Client) writeExpiresAt(w *multipart.Writer, fieldTag string, expiresAt int64) error {
+	if fieldTag != ExpiresAtKey || expiresAt <= 0 {
+		return nil
+	}
+	if expiresAt <= time.Now().Unix() {
+		return fmt.Errorf("expires_at must be in the future: %s", time.Unix(expiresAt, 0).UTC().Format(time.RFC3339))
+	}
+
+	formField, err := w.CreateFormField(fieldTag)
+	if err != nil {
+		return err
+	}
+	formField.Write([]byte(strconv.FormatInt(expiresAt, 10)))
+	return nil
+}
+
+// writeAttachments – Writes the attachments requested from signers as attachments[i][field]
+func (m *Client) writeAttachments(w *multipart.Writer, attachments []model.Attachment) error {
+	for i, attachment := range attachments {
+		prefix := fmt.Sprintf("%s[%v]", AttachmentsKey, i)
+		fields := []struct{ key, value string }{
+			{"name", attachment.GetName()},
+			{"signer_index", strconv.Itoa(attachment.GetSignerIndex())},
+			{"instructions", attachment.GetInstructions()},
+			{"required", m.boolToIntString(attachment.GetRequired())},
+		}
+		for _, field := range fields {
+			if field.value == "" {
+				continue
+			}
+			formField, err := w.CreateFormField(fmt.Sprintf("%s[%s]", prefix, field.key))
+			if err != nil {
+				return err
+			}
+			formField.Write([]byte(field.value))
+		}
+	}
+	return nil
+}
+
+// writeFilePath – Opens the file at path and streams it into a new file part of the multipart body
+func (m *Client) writeFilePath(w *multipart.Writer, fieldName string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return m.writeFileUpload(w, fieldName, model.FileUpload{Name: file.Name(), Reader: file})
+}
+
+// writeFileUpload – Streams a FileUpload into a new file part of the multipart body
+func (m *Client) writeFileUpload(w *multipart.Writer, fieldName string, upload model.FileUpload) error {
+	if upload.GetReader() == nil {
+		return fmt.Errorf("file upload %s has no reader", upload.GetName())
+	}
+
+	formField, err := w.CreateFormFile(fieldName, upload.GetName())
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(formField, upload.GetReader())
+	return err
+}
+
 // parseSignatureRequestResponse – Parses the signature request response and converts it into the signature request model
 func (m *Client) parseSignatureRequestResponse(response *http.Response) (*model.SignatureRequest, error) {
 	defer response.Body.Close()
@@ -443,10 +1606,69 @@ func (m *Client) parseSignatureRequestResponse(response *http.Response) (*model.
 	err := json.NewDecoder(response.Body).Decode(sigRequestResponse)
 
 	sigRequest := sigRequestResponse.GetSignatureRequest()
+	m.appendResponseWarnings(sigRequest, sigRequestResponse.GetWarnings())
 
 	return sigRequest, err
 }
 
+// parseSignatureRequestResponseRaw – Reads and closes the response body, parses it like parseSignatureRequestResponse and
+// replaces the body with an in-memory copy so the caller can still inspect it
+func (m *Client) parseSignatureRequestResponseRaw(response *http.Response) (*model.SignatureRequest, *http.Response, error) {
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	sigRequestResponse := &model.SignatureRequestResponse{}
+	err = json.Unmarshal(body, sigRequestResponse)
+	if err != nil {
+		return nil, response, err
+	}
+
+	sigRequest := sigRequestResponse.GetSignatureRequest()
+	m.appendResponseWarnings(sigRequest, sigRequestResponse.GetWarnings())
+
+	return sigRequest, response, nil
+}
+
+// appendResponseWarnings – Copies the top-level warnings of the response onto the signature request, so they are not discarded
+func (m *Client) appendResponseWarnings(sigRequest *model.SignatureRequest, warnings []model.Warning) {
+	if sigRequest == nil {
+		return
+	}
+	for i := range warnings {
+		sigRequest.Warnings = append(sigRequest.Warnings, &warnings[i])
+	}
+}
+
+// parseOperationResult – Converts an error status into a *model.APIError, otherwise reads any warnings from the body. The body is always closed.
+func (m *Client) parseOperationResult(response *http.Response) (*model.OperationResult, error) {
+	if err := m.checkResponse(response); err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	result := &model.OperationResult{StatusCode: response.StatusCode}
+
+	// Most actions respond with an empty body, so a body that doesn't decode isn't an error
+	data := &model.ErrorResponse{}
+	if err := json.NewDecoder(response.Body).Decode(data); err == nil {
+		result.Warnings = data.GetWarnings()
+	}
+
+	return result, nil
+}
+
+// boolFieldValue – Encodes a bool form field, forcing test_mode on when the client has ForceTestMode set
+func (m *Client) boolFieldValue(fieldTag string, value bool) string {
+	if fieldTag == TestModeKey && m.ForceTestMode {
+		return m.boolToIntString(true)
+	}
+	return m.boolToIntString(value)
+}
+
 func (m *Client) boolToIntString(value bool) string {
 	if value == true {
 		return "1"
@@ -5,12 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/DeputyApp/hellosign-go-sdk/model"
-	"io"
 	"mime/multipart"
 	"net/http"
-	"os"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 const (
@@ -23,6 +22,10 @@ const (
 	MetadataKey    string = "metadata"
 	SignerRolesKey string = "signer_roles"
 	FileURLKey     string = "file_url"
+	AccountIDKey   string = "account_id"
+	EmailKey       string = "email_address"
+	CCRolesKey     string = "cc_roles"
+	MergeFieldsKey string = "merge_fields"
 )
 
 // CreateEmbeddedTemplate creates a new embedded Template
@@ -42,10 +45,155 @@ func (m *Client) CreateEmbeddedTemplate(req model.CreateEmbeddedTemplateRequest)
 	return resp.GetTemplate(), err
 }
 
+// CreateEmbeddedTemplateDraft creates a new embedded template draft with the editor options of model.CreateEmbeddedTemplateDraftRequest.
+// The returned template holds the template ID and the edit URL.
+func (m *Client) CreateEmbeddedTemplateDraft(req model.CreateEmbeddedTemplateDraftRequest) (*model.EmbeddedTemplate, error) {
+	params, writer, err := m.marshalMultipartCreateEmbeddedTemplateDraftRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post("template/create_embedded_draft", params, *writer)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	resp := &model.CreateEmbeddedTemplateResponse{}
+	err = json.NewDecoder(response.Body).Decode(resp)
+	return resp.GetTemplate(), err
+}
+
+// GetTemplate retrieves the signer roles, custom fields and documents of a template
+func (m *Client) GetTemplate(templateID string) (*model.Template, error) {
+	path := fmt.Sprintf("template/%s", templateID)
+	response, err := m.get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseTemplateResponse(response)
+}
+
+// GetTemplateFieldSchema fetches the template and groups its documents' form fields by the name of the signer role that fills them in
+func (m *Client) GetTemplateFieldSchema(templateID string) (map[string][]model.TemplateField, error) {
+	template, err := m.GetTemplate(templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := template.GetSignerRoles()
+	schema := make(map[string][]model.TemplateField)
+	for _, document := range template.GetDocuments() {
+		for _, field := range document.FormFields {
+			// signer is the role's 1-based position, fall back to the raw value if it doesn't match a role
+			role := field.GetSigner()
+			if n, err := strconv.Atoi(role); err == nil && n >= 1 && n <= len(roles) {
+				role = roles[n-1].GetName()
+			}
+
+			schema[role] = append(schema[role], model.TemplateField{
+				DocumentIndex: document.GetIndex(),
+				DocumentName:  document.GetName(),
+				APIId:         field.GetAPIId(),
+				Name:          field.GetName(),
+				Type:          field.GetType(),
+				Page:          field.GetPage(),
+				X:             field.GetX(),
+				Y:             field.GetY(),
+				Width:         field.GetWidth(),
+				Height:        field.GetHeight(),
+				Required:      field.GetRequired(),
+			})
+		}
+	}
+	return schema, nil
+}
+
+// GetTemplateCustomFieldNames fetches the template and returns the names of its custom fields, so values can be checked before sending
+func (m *Client) GetTemplateCustomFieldNames(templateID string) ([]string, error) {
+	template, err := m.GetTemplate(templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Custom fields may be listed on the template, on its documents, or both
+	customFields := template.GetCustomFields()
+	for _, document := range template.GetDocuments() {
+		customFields = append(customFields, document.GetCustomFields()...)
+	}
+
+	names := []string{}
+	seen := make(map[string]bool)
+	for _, cf := range customFields {
+		if !seen[cf.GetName()] {
+			seen[cf.GetName()] = true
+			names = append(names, cf.GetName())
+		}
+	}
+	return names, nil
+}
+
+// ValidateSignerRoles fetches the template and checks that every role name exists on it, so a mistyped role is caught before sending.
+// It returns an *InvalidSignerRolesError listing the names the template doesn't define.
+func (m *Client) ValidateSignerRoles(templateID string, roles []model.SignerRole) error {
+	template, err := m.GetTemplate(templateID)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool)
+	for _, role := range template.GetSignerRoles() {
+		known[role.GetName()] = true
+	}
+
+	var invalid []string
+	for _, role := range roles {
+		if !known[role.GetName()] {
+			invalid = append(invalid, role.GetName())
+		}
+	}
+
+	if len(invalid) > 0 {
+		return &InvalidSignerRolesError{TemplateID: templateID, Names: invalid}
+	}
+	return nil
+}
+
+// InvalidSignerRolesError is returned by ValidateSignerRoles when signer roles are not defined on the template
+type InvalidSignerRolesError struct {
+	TemplateID string
+	Names      []string // The role names not found on the template.
+}
+
+func (e *InvalidSignerRolesError) Error() string {
+	return fmt.Sprintf("template %s has no signer roles named: %s", e.TemplateID, strings.Join(e.Names, ", "))
+}
+
+// GetTemplateFiles - Obtain a copy of the documents of the template specified by the template_id parameter.
+// templateID - The id of the Template to retrieve.
+// fileType - Set to "pdf" for a single merged document or "zip" for a collection of individual documents.
+func (m *Client) GetTemplateFiles(templateID, fileType string) ([]byte, error) {
+	return m.getFiles(fmt.Sprintf("template/files/%s", templateID), fileType, nil)
+}
+
+// GetTemplateFilesURL - Obtain a temporary download link for the documents of the template specified by the template_id parameter.
+func (m *Client) GetTemplateFilesURL(templateID, fileType string) (*model.FileURLResponse, error) {
+	data := &model.FileURLResponse{}
+	if _, err := m.getFiles(fmt.Sprintf("template/files/%s", templateID), fileType, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
 // ListTemplates retrieves a list that are accessible by your account
 func (m *Client) ListTemplates() (*model.ListTemplatesResponse, error) {
-	path := fmt.Sprintf("template/list")
-	response, err := m.get(path)
+	return m.ListTemplatesWithParams(model.ListParams{})
+}
+
+// ListTemplatesWithParams retrieves a page of the templates accessible by your account, optionally filtered by query or account_id.
+// Zero values in params are omitted so HelloSign's defaults apply.
+func (m *Client) ListTemplatesWithParams(params model.ListParams) (*model.ListTemplatesResponse, error) {
+	response, err := m.getWithQuery("template/list", m.listQuery(params))
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +221,102 @@ func (m *Client) DeleteTemplate(templateID string) (*http.Response, error) {
 	return response, err
 }
 
+// AddUserToTemplate gives the account identified by accountID or email access to the template.
+// Exactly one of accountID and email must be provided.
+func (m *Client) AddUserToTemplate(templateID string, accountID string, email string) (*model.Template, error) {
+	return m.updateTemplateUser(fmt.Sprintf("template/add_user/%s", templateID), accountID, email)
+}
+
+// RemoveUserFromTemplate removes the access of the account identified by accountID or email to the template.
+// Exactly one of accountID and email must be provided.
+func (m *Client) RemoveUserFromTemplate(templateID string, accountID string, email string) (*model.Template, error) {
+	return m.updateTemplateUser(fmt.Sprintf("template/remove_user/%s", templateID), accountID, email)
+}
+
+// ReassignTemplates gives toEmail access to every template fromEmail can access, then removes fromEmail from it,
+// eg: when an employee leaves. It returns how many templates were reassigned, including when it stops on an error.
+func (m *Client) ReassignTemplates(fromEmail string, toEmail string) (int, error) {
+	if fromEmail == "" || toEmail == "" {
+		return 0, fmt.Errorf("invalid argument: fromEmail and toEmail are required")
+	}
+	if strings.EqualFold(fromEmail, toEmail) {
+		// Adding then removing the same account would strip its access to every template
+		return 0, fmt.Errorf("invalid argument: fromEmail and toEmail are both %s", fromEmail)
+	}
+
+	// Collect every page before changing access, so the reassignment can't shift the pages being read
+	var templates []*model.Template
+	for page := 1; ; page++ {
+		listResponse, err := m.ListTemplatesWithParams(model.ListParams{Page: page})
+		if err != nil {
+			return 0, err
+		}
+		templates = append(templates, listResponse.GetTemplates()...)
+		if page >= listResponse.GetListInfo().GetNumPages() {
+			break
+		}
+	}
+
+	reassigned := 0
+	for _, template := range templates {
+		if !m.templateHasAccount(template, fromEmail) {
+			continue
+		}
+		if _, err := m.AddUserToTemplate(template.GetTemplateID(), "", toEmail); err != nil {
+			return reassigned, err
+		}
+		if _, err := m.RemoveUserFromTemplate(template.GetTemplateID(), "", fromEmail); err != nil {
+			return reassigned, err
+		}
+		reassigned++
+	}
+	return reassigned, nil
+}
+
+// templateHasAccount – Reports whether the account with email can access the template
+func (m *Client) templateHasAccount(template *model.Template, email string) bool {
+	for _, account := range template.GetAccounts() {
+		if strings.EqualFold(account.GetEmailAddress(), email) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Client) updateTemplateUser(path string, accountID string, email string) (*model.Template, error) {
+	params, writer, err := m.marshalMultipartAccountIdentifier(accountID, email, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post(path, params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseTemplateResponse(response)
+}
+
+// UpdateTemplateFiles - Replaces the documents of an existing template. The template is returned with a new edit URL.
+func (m *Client) UpdateTemplateFiles(templateID string, req model.UpdateTemplateFilesRequest) (*model.Template, error) {
+	if templateID == "" {
+		return nil, fmt.Errorf("invalid argument: %s", templateID)
+	}
+	path := fmt.Sprintf("template/update_files/%s", templateID)
+
+	params, writer, err := m.marshalMultipartSignatureRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post(path, params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseTemplateResponse(response)
+}
+
 // GetEmbeddedTemplateEditURL - Retrieves an embedded template object.
 func (m *Client) GetEmbeddedTemplateEditURL(templateID string) (*model.EmbeddedTemplateEditURL, error) {
 	if templateID == "" {
@@ -93,10 +337,123 @@ func (m *Client) GetEmbeddedTemplateEditURL(templateID string) (*model.EmbeddedT
 	return data.GetEmbedded(), nil
 }
 
+// GetEmbeddedTemplateEditURLWithOptions - Retrieves an embedded template edit URL, restricting what the editor may change.
+func (m *Client) GetEmbeddedTemplateEditURLWithOptions(templateID string, opts model.TemplateEditOptions) (*model.EmbeddedTemplateEditURL, error) {
+	if templateID == "" {
+		return nil, fmt.Errorf("invalid argument: %s", templateID)
+	}
+	path := fmt.Sprintf("embedded/edit_url/%s", templateID)
+
+	params, writer, err := m.marshalMultipartTemplateEditOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post(path, params, *writer)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	data := &model.EmbeddedTemplateResponse{}
+	err = json.NewDecoder(response.Body).Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return data.GetEmbedded(), nil
+}
+
+func (m *Client) marshalMultipartTemplateEditOptions(opts model.TemplateEditOptions) (*bytes.Buffer, *multipart.Writer, error) {
+	var b bytes.Buffer
+	w, err := m.newMultipartWriter(&b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	structType := reflect.TypeOf(opts)
+	val := reflect.ValueOf(opts)
+
+	for i := 0; i < val.NumField(); i++ {
+		valueField := val.Field(i)
+		f := valueField.Interface()
+		val := reflect.ValueOf(f)
+		field := structType.Field(i)
+		fieldTag := field.Tag.Get(FormFieldKey)
+
+		switch val.Kind() {
+		case reflect.Slice:
+			switch fieldTag {
+			case CCRolesKey:
+				if err := m.writeCCRoles(w, f.([]string)); err != nil {
+					return nil, nil, err
+				}
+			case MergeFieldsKey:
+				if err := m.writeMergeFields(w, f.([]model.MergeField)); err != nil {
+					return nil, nil, err
+				}
+			}
+		case reflect.Bool:
+			formField, err := w.CreateFormField(fieldTag)
+			if err != nil {
+				return nil, nil, err
+			}
+			formField.Write([]byte(m.boolFieldValue(fieldTag, val.Bool())))
+		}
+	}
+
+	w.Close()
+	return &b, w, nil
+}
+
+// writeCCRoles – Writes the template's CC role names as cc_roles[i]
+func (m *Client) writeCCRoles(w *multipart.Writer, roles []string) error {
+	for i, role := range roles {
+		formField, err := w.CreateFormField(fmt.Sprintf("%s[%v]", CCRolesKey, i))
+		if err != nil {
+			return err
+		}
+		formField.Write([]byte(role))
+	}
+	return nil
+}
+
+// writeMergeFields – Writes the merge fields as a JSON array, omitting the field when there are none
+func (m *Client) writeMergeFields(w *multipart.Writer, mergeFields []model.MergeField) error {
+	if len(mergeFields) == 0 {
+		return nil
+	}
+	formField, err := w.CreateFormField(MergeFieldsKey)
+	if err != nil {
+		return err
+	}
+	mfJSON, err := json.Marshal(mergeFields)
+	if err != nil {
+		return err
+	}
+	formField.Write(mfJSON)
+	return nil
+}
+
+// parseTemplateResponse – Parses the template response and converts it into the template model
+func (m *Client) parseTemplateResponse(response *http.Response) (*model.Template, error) {
+	defer response.Body.Close()
+
+	templateResponse := &model.TemplateResponse{}
+	err := json.NewDecoder(response.Body).Decode(templateResponse)
+
+	return templateResponse.GetTemplate(), err
+}
+
 func (m *Client) marshalMultipartCreateEmbeddedTemplateRequest(embRequest model.CreateEmbeddedTemplateRequest) (*bytes.Buffer, *multipart.Writer, error) {
+	if err := m.validateFileSources(embRequest); err != nil {
+		return nil, nil, err
+	}
 
 	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
+	w, err := m.newMultipartWriter(&b)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	structType := reflect.TypeOf(embRequest)
 	val := reflect.ValueOf(embRequest)
@@ -112,7 +469,9 @@ func (m *Client) marshalMultipartCreateEmbeddedTemplateRequest(embRequest model.
 		switch val.Kind() {
 		case reflect.Map:
 			if fieldTag == MetadataKey {
-				for k, v := range embRequest.GetMetadata() {
+				metadata := embRequest.GetMetadata()
+				for _, k := range m.sortedKeys(metadata) {
+					v := metadata[k]
 					formField, err := w.CreateFormField(fmt.Sprintf("%s[%v]", MetadataKey, k))
 					if err != nil {
 						return nil, nil, err
@@ -154,13 +513,9 @@ func (m *Client) marshalMultipartCreateEmbeddedTemplateRequest(embRequest model.
 				}
 			case FileKey:
 				for i, path := range embRequest.GetFile() {
-					file, _ := os.Open(path)
-
-					formField, err := w.CreateFormFile(fmt.Sprintf("%s[%v]", FileKey, i), file.Name())
-					if err != nil {
+					if err := m.writeFilePath(w, fmt.Sprintf("%s[%v]", FileKey, i), path); err != nil {
 						return nil, nil, err
 					}
-					_, err = io.Copy(formField, file)
 				}
 			case FileURLKey:
 				for i, fileURL := range embRequest.GetFileURL() {
@@ -206,7 +561,115 @@ func (m *Client) marshalMultipartCreateEmbeddedTemplateRequest(embRequest model.
 			if err != nil {
 				return nil, nil, err
 			}
-			formField.Write([]byte(m.boolToIntString(val.Bool())))
+			formField.Write([]byte(m.boolFieldValue(fieldTag, val.Bool())))
+		default:
+			if val.String() != "" {
+				formField, err := w.CreateFormField(fieldTag)
+				if err != nil {
+					return nil, nil, err
+				}
+				formField.Write([]byte(val.String()))
+			}
+		}
+	}
+
+	w.Close()
+	return &b, w, nil
+}
+
+func (m *Client) marshalMultipartCreateEmbeddedTemplateDraftRequest(req model.CreateEmbeddedTemplateDraftRequest) (*bytes.Buffer, *multipart.Writer, error) {
+	if err := m.validateFileSources(req); err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	w, err := m.newMultipartWriter(&b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	structType := reflect.TypeOf(req)
+	val := reflect.ValueOf(req)
+
+	// file[] indexes continue across File and FileUploads
+	fileIndex := 0
+
+	for i := 0; i < val.NumField(); i++ {
+		valueField := val.Field(i)
+		f := valueField.Interface()
+		val := reflect.ValueOf(f)
+		field := structType.Field(i)
+		fieldTag := field.Tag.Get(FormFieldKey)
+
+		switch val.Kind() {
+		case reflect.Map:
+			values := f.(map[string]string)
+			for _, k := range m.sortedKeys(values) {
+				v := values[k]
+				formField, err := w.CreateFormField(fmt.Sprintf("%s[%v]", fieldTag, k))
+				if err != nil {
+					return nil, nil, err
+				}
+				formField.Write([]byte(v))
+			}
+		case reflect.Slice:
+			switch fieldTag {
+			case SignerRolesKey:
+				for i, sr := range f.([]model.SignerRole) {
+					name, err := w.CreateFormField(fmt.Sprintf("%s[%v][name]", SignerRolesKey, i))
+					if err != nil {
+						return nil, nil, err
+					}
+					name.Write([]byte(sr.GetName()))
+
+					if sr.GetOrder() != 0 {
+						order, err := w.CreateFormField(fmt.Sprintf("%s[%v][order]", SignerRolesKey, i))
+						if err != nil {
+							return nil, nil, err
+						}
+						order.Write([]byte(strconv.Itoa(sr.GetOrder())))
+					}
+				}
+			case CCRolesKey:
+				if err := m.writeCCRoles(w, f.([]string)); err != nil {
+					return nil, nil, err
+				}
+			case MergeFieldsKey:
+				if err := m.writeMergeFields(w, f.([]model.MergeField)); err != nil {
+					return nil, nil, err
+				}
+			case FileKey:
+				switch files := f.(type) {
+				case []string:
+					for _, path := range files {
+						if err := m.writeFilePath(w, fmt.Sprintf("%s[%v]", FileKey, fileIndex), path); err != nil {
+							return nil, nil, err
+						}
+						fileIndex++
+					}
+				case []model.FileUpload:
+					for _, upload := range files {
+						if err := m.writeFileUpload(w, fmt.Sprintf("%s[%v]", FileKey, fileIndex), upload); err != nil {
+							return nil, nil, err
+						}
+						fileIndex++
+					}
+				}
+			case FileURLKey:
+				for i, fileURL := range f.([]string) {
+					formField, err := w.CreateFormField(fmt.Sprintf("%s[%v]", FileURLKey, i))
+					if err != nil {
+						return nil, nil, err
+					}
+					formField.Write([]byte(fileURL))
+				}
+			}
+		case reflect.Bool:
+			formField, err := w.CreateFormField(fieldTag)
+			if err != nil {
+				return nil, nil, err
+			}
+			formField.Write([]byte(m.boolFieldValue(fieldTag, val.Bool())))
 		default:
 			if val.String() != "" {
 				formField, err := w.CreateFormField(fieldTag)
@@ -1,11 +1,14 @@
 package hellosign
 
 import (
+	"bytes"
 	"encoding/json"
 	"github.com/DeputyApp/hellosign-go-sdk/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -24,6 +27,50 @@ func TestClient_GetEmbeddedTemplateEditURL(t *testing.T) {
 	assert.Equal(t, 1630908730, res.GetExpiresAt())
 }
 
+func TestClient_GetEmbeddedTemplateEditURLWithOptions(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/get_embedded_template_edit_url_with_options")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetEmbeddedTemplateEditURLWithOptions("76a888f4ca1dc1f726cbfd3381d7b9a19066c047", createTemplateEditOptions())
+
+	assert.NotNil(t, res, "Should return response")
+	assert.Nil(t, err, "Should not return error")
+
+	assert.Contains(t, res.GetEditURL(), "cached_params_token=")
+	assert.Equal(t, 1630912330, res.GetExpiresAt())
+}
+
+func TestTemplateEditOptionsMarshalling(t *testing.T) {
+	client := Client{}
+
+	params, writer, err := client.marshalMultipartTemplateEditOptions(createTemplateEditOptions())
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{`[{"name":"Salary","type":"text"},{"name":"Relocation","type":"checkbox"}]`}, form.Value[MergeFieldsKey])
+	assert.Equal(t, []string{"Accounting"}, form.Value["cc_roles[0]"])
+	assert.Equal(t, []string{"1"}, form.Value["skip_signer_roles"])
+	assert.Equal(t, []string{"0"}, form.Value["skip_subject_message"])
+	assert.Equal(t, []string{"1"}, form.Value["force_signer_roles"])
+	assert.Equal(t, []string{"0"}, form.Value["preview_only"])
+}
+
+func createTemplateEditOptions() model.TemplateEditOptions {
+	return model.TemplateEditOptions{
+		TestMode: true,
+		CCRoles:  []string{"Accounting"},
+		MergeFields: []model.MergeField{
+			{Name: "Salary", Type: "text"},
+			{Name: "Relocation", Type: "checkbox"},
+		},
+		SkipSignerRoles:  true,
+		ForceSignerRoles: true,
+	}
+}
+
 func TestClient_CreateEmbeddedTemplate(t *testing.T) {
 	vcr := fixture("fixtures/docsignature_template/create_embedded_template")
 	defer vcr.Stop()
@@ -69,6 +116,308 @@ func TestClient_CreateEmbeddedTemplate(t *testing.T) {
 	assert.NotEmpty(t, res.GetExpiresAt())
 }
 
+func TestClient_CreateEmbeddedTemplateDraft(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/create_embedded_template_draft")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.CreateEmbeddedTemplateDraft(createEmbeddedTemplateDraftRequest())
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "61a832ff0d8423f91d503e76bfbcc750f7417c78", res.GetTemplateID())
+	assert.Contains(t, res.GetEditURL(), "embeddedTemplate?token=")
+}
+
+func TestCreateEmbeddedTemplateDraftMarshalling(t *testing.T) {
+	client := Client{}
+
+	params, writer, err := client.marshalMultipartCreateEmbeddedTemplateDraftRequest(createEmbeddedTemplateDraftRequest())
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{`[{"name":"Salary","type":"text"},{"name":"Relocation","type":"checkbox"}]`}, form.Value["merge_fields"])
+	assert.Equal(t, []string{"1"}, form.Value["can_reorder_signers"])
+	assert.Equal(t, []string{"0"}, form.Value["allow_reassign"])
+	assert.Equal(t, []string{"Employee"}, form.Value["signer_roles[0][name]"])
+	assert.Equal(t, []string{"Accounting"}, form.Value["cc_roles[0]"])
+	assert.Len(t, form.File["file[0]"], 1)
+}
+
+func TestClient_CreateEmbeddedTemplateMissingFile(t *testing.T) {
+	client := Client{}
+
+	req := model.CreateEmbeddedTemplateRequest{
+		TestMode: true,
+		File:     []string{"fixtures/does_not_exist.pdf"},
+		Title:    "Offer Letter",
+	}
+
+	res, err := client.CreateEmbeddedTemplate(req)
+	assert.Nil(t, res, "Should not return response")
+	require.NotNil(t, err, "Should return error")
+	assert.True(t, os.IsNotExist(err), "Should return a file not found error")
+}
+
+func TestClient_GetTemplate(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/get_template")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.GetTemplate("f57db65d3f933b5316d398057a36176831451a35")
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "f57db65d3f933b5316d398057a36176831451a35", res.GetTemplateID())
+	assert.Equal(t, "Offer Letter", res.GetTitle())
+	assert.Equal(t, "Please sign the offer letter", res.GetMessage())
+
+	require.Len(t, res.GetSignerRoles(), 2)
+	assert.Equal(t, "Employee", res.GetSignerRoles()[0].GetName())
+	assert.Equal(t, 1, res.GetSignerRoles()[1].GetOrder())
+
+	require.Len(t, res.GetCCRoles(), 1)
+	assert.Equal(t, "Accounting", res.GetCCRoles()[0].GetName())
+
+	assert.Len(t, res.GetDocuments(), 2)
+	assert.Len(t, res.GetAccounts(), 1)
+}
+
+func TestClient_GetTemplateCustomFieldNames(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/get_template")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	names, err := client.GetTemplateCustomFieldNames("f57db65d3f933b5316d398057a36176831451a35")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, []string{"Salary", "Start Date"}, names)
+}
+
+func TestClient_GetTemplateFieldSchema(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/get_template")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	schema, err := client.GetTemplateFieldSchema("f57db65d3f933b5316d398057a36176831451a35")
+	require.Nil(t, err, "Should not return error")
+	require.Len(t, schema, 2, "Should group fields by signer role")
+
+	employee := schema["Employee"]
+	require.Len(t, employee, 3, "Should collect the role's fields across documents")
+	assert.Equal(t, "a97c8e_3", employee[0].GetAPIId())
+	assert.Equal(t, "signature", employee[0].GetType())
+	assert.Equal(t, 80, employee[0].GetX())
+	assert.Equal(t, 600, employee[0].GetY())
+	assert.Equal(t, 0, employee[0].GetDocumentIndex())
+	assert.Equal(t, "Acknowledged", employee[2].GetName())
+	assert.Equal(t, "handbook_acknowledgement.pdf", employee[2].GetDocumentName())
+	assert.Equal(t, 1, employee[2].GetDocumentIndex())
+	assert.Equal(t, 2, employee[2].GetPage())
+
+	manager := schema["Manager"]
+	require.Len(t, manager, 1)
+	assert.Equal(t, "Manager Signature", manager[0].GetName())
+	assert.True(t, manager[0].GetRequired())
+}
+
+func TestClient_GetTemplateCustomFieldNamesEmpty(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		return stubResponse(200, `{"template":{"template_id":"abc123","documents":[{"name":"blank.pdf","custom_fields":[]}]}}`), nil
+	})
+
+	names, err := client.GetTemplateCustomFieldNames("abc123")
+	require.Nil(t, err, "Should not return error")
+
+	assert.NotNil(t, names, "Should return an empty slice")
+	assert.Len(t, names, 0)
+}
+
+func TestClient_ValidateSignerRoles(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/get_template")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	err := client.ValidateSignerRoles("f57db65d3f933b5316d398057a36176831451a35", []model.SignerRole{
+		{Name: "Employee"},
+		{Name: "Manger"},
+	})
+	require.NotNil(t, err, "Should return error")
+
+	rolesErr, ok := err.(*InvalidSignerRolesError)
+	require.True(t, ok, "Should return an InvalidSignerRolesError")
+	assert.Equal(t, []string{"Manger"}, rolesErr.Names)
+	assert.Equal(t, "template f57db65d3f933b5316d398057a36176831451a35 has no signer roles named: Manger", err.Error())
+}
+
+func TestClient_ValidateSignerRolesValid(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/get_template")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	err := client.ValidateSignerRoles("f57db65d3f933b5316d398057a36176831451a35", []model.SignerRole{
+		{Name: "Employee"},
+		{Name: "Manager"},
+	})
+	assert.Nil(t, err, "Should not return error")
+}
+
+func TestClient_GetTemplateFiles(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/get_template_files")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	data, err := client.GetTemplateFiles("f57db65d3f933b5316d398057a36176831451a35", "pdf")
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, data, "Should return response")
+
+	assert.True(t, bytes.HasPrefix(data, []byte("%PDF-")), "Should return the pdf bytes")
+	assert.Equal(t, 135, len(data))
+}
+
+func TestClient_UpdateTemplateFiles(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/update_template_files")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	req := model.UpdateTemplateFilesRequest{
+		TestMode: true,
+		File:     []string{"fixtures/offer_letter.pdf"},
+		Subject:  "Offer Letter v2",
+	}
+	res, err := client.UpdateTemplateFiles("f57db65d3f933b5316d398057a36176831451a35", req)
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0", res.GetTemplateID())
+	assert.Contains(t, res.GetEditURL(), "cached_params_token=")
+	assert.Equal(t, 1630915930, res.GetExpiresAt())
+}
+
+func TestUpdateTemplateFilesMarshalling(t *testing.T) {
+	client := Client{}
+
+	req := model.UpdateTemplateFilesRequest{
+		File: []string{"fixtures/offer_letter.pdf"},
+		FileUploads: []model.FileUpload{
+			{Name: "handbook.pdf", Reader: strings.NewReader("%PDF-1.4 handbook")},
+		},
+	}
+	params, writer, err := client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	require.Len(t, form.File["file[0]"], 1)
+	assert.Equal(t, "offer_letter.pdf", form.File["file[0]"][0].Filename)
+	require.Len(t, form.File["file[1]"], 1)
+	assert.Equal(t, "handbook.pdf", form.File["file[1]"][0].Filename)
+}
+
+func TestClient_AddUserToTemplate(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/add_user_to_template")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.AddUserToTemplate("f57db65d3f933b5316d398057a36176831451a35", "", "george@example.com")
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	require.Len(t, res.GetAccounts(), 2)
+	assert.Equal(t, "george@example.com", res.GetAccounts()[1].GetEmailAddress())
+}
+
+func TestClient_RemoveUserFromTemplate(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/remove_user_from_template")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.RemoveUserFromTemplate("f57db65d3f933b5316d398057a36176831451a35", "c7a1e3b5d9f2a4c6e8b0d1f3a5c7e9b2d4f6a8c0", "")
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	require.Len(t, res.GetAccounts(), 1)
+	assert.Equal(t, "me@hellosign.com", res.GetAccounts()[0].GetEmailAddress())
+}
+
+func TestClient_TemplateUserRequiresExactlyOneIdentifier(t *testing.T) {
+	client := Client{}
+
+	res, err := client.AddUserToTemplate("f57db65d3f933b5316d398057a36176831451a35", "", "")
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, "exactly one of account_id or email_address must be provided")
+
+	res, err = client.RemoveUserFromTemplate("f57db65d3f933b5316d398057a36176831451a35", "c7a1e3b5d9f2a4c6e8b0d1f3a5c7e9b2d4f6a8c0", "george@example.com")
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, "exactly one of account_id or email_address must be provided")
+}
+
+func TestClient_ReassignTemplates(t *testing.T) {
+	var updates []string
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/v3/template/list":
+			if r.URL.Query().Get("page") == "1" {
+				return stubResponse(200, `{"list_info":{"page":1,"num_pages":2,"num_results":3,"page_size":2},"templates":[`+
+					templateJSON("f57db65d3f933b5316d398057a36176831451a35", "leaver@example.com")+`,`+
+					templateJSON("a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0", "someone@example.com")+`]}`), nil
+			}
+			return stubResponse(200, `{"list_info":{"page":2,"num_pages":2,"num_results":3,"page_size":2},"templates":[`+
+				templateJSON("c26b8a16784a872da37ea946b9ddec7c1e11dff6", "Leaver@example.com")+`]}`), nil
+		default:
+			form := readRequestForm(t, r)
+			updates = append(updates, r.URL.Path+" "+form.Value["email_address"][0])
+			return stubResponse(200, `{"template":{}}`), nil
+		}
+	})
+
+	count, err := client.ReassignTemplates("leaver@example.com", "manager@example.com")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{
+		"/v3/template/add_user/f57db65d3f933b5316d398057a36176831451a35 manager@example.com",
+		"/v3/template/remove_user/f57db65d3f933b5316d398057a36176831451a35 leaver@example.com",
+		"/v3/template/add_user/c26b8a16784a872da37ea946b9ddec7c1e11dff6 manager@example.com",
+		"/v3/template/remove_user/c26b8a16784a872da37ea946b9ddec7c1e11dff6 leaver@example.com",
+	}, updates)
+}
+
+func TestClient_ReassignTemplatesStopsOnError(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/v3/template/list":
+			return stubResponse(200, `{"list_info":{"page":1,"num_pages":1,"num_results":2,"page_size":20},"templates":[`+
+				templateJSON("f57db65d3f933b5316d398057a36176831451a35", "leaver@example.com")+`,`+
+				templateJSON("c26b8a16784a872da37ea946b9ddec7c1e11dff6", "leaver@example.com")+`]}`), nil
+		case "/v3/template/add_user/c26b8a16784a872da37ea946b9ddec7c1e11dff6":
+			return stubResponse(403, `{"error":{"error_msg":"You do not have access to this template","error_name":"forbidden"}}`), nil
+		default:
+			return stubResponse(200, `{"template":{}}`), nil
+		}
+	})
+
+	count, err := client.ReassignTemplates("leaver@example.com", "manager@example.com")
+	assert.NotNil(t, err, "Should return error")
+	assert.Equal(t, 1, count, "Should return the templates reassigned before the error")
+}
+
+func TestClient_ReassignTemplatesSameEmail(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Errorf("Should not send a request, got %s", r.URL.Path)
+		return stubResponse(200, `{"template":{}}`), nil
+	})
+
+	count, err := client.ReassignTemplates("leaver@example.com", "Leaver@Example.com")
+	assert.EqualError(t, err, "invalid argument: fromEmail and toEmail are both leaver@example.com")
+	assert.Equal(t, 0, count, "Should not reassign any templates")
+}
+
+func templateJSON(templateID string, email string) string {
+	return `{"template_id":"` + templateID + `","accounts":[{"account_id":"5008b25c7f67153e57d5a357b1687968068fb465","email_address":"me@hellosign.com"},{"email_address":"` + email + `"}]}`
+}
+
 func TestClient_ListTemplates(t *testing.T) {
 	vcr := fixture("fixtures/docsignature_template/list_templates")
 	defer vcr.Stop()
@@ -83,6 +432,23 @@ func TestClient_ListTemplates(t *testing.T) {
 	assert.Equal(t, res.GetListInfo().GetNumResults(), len(res.GetTemplates()))
 }
 
+func TestClient_ListTemplatesWithParams(t *testing.T) {
+	vcr := fixture("fixtures/docsignature_template/list_templates_with_params")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+
+	// the cassette only matches template/list?page=2&page_size=1&query=offer
+	res, err := client.ListTemplatesWithParams(model.ListParams{Page: 2, PageSize: 1, Query: "offer"})
+	assert.NotNil(t, res, "Should return response")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, 2, res.GetListInfo().GetPage())
+	assert.Equal(t, 3, res.GetListInfo().GetNumPages())
+	require.Len(t, res.GetTemplates(), 1)
+	assert.Equal(t, "Offer Letter - Engineering", res.GetTemplates()[0].GetTitle())
+}
+
 func TestClient_DeleteTemplate(t *testing.T) {
 	vcr := fixture("fixtures/docsignature_template/delete_template")
 	defer vcr.Stop()
@@ -92,3 +458,18 @@ func TestClient_DeleteTemplate(t *testing.T) {
 	assert.NotNil(t, res, "Should return response")
 	assert.Nil(t, err, "Should not return error")
 }
+
+func createEmbeddedTemplateDraftRequest() model.CreateEmbeddedTemplateDraftRequest {
+	return model.CreateEmbeddedTemplateDraftRequest{
+		TestMode:    true,
+		File:        []string{"fixtures/offer_letter.pdf"},
+		Title:       "Offer Letter",
+		SignerRoles: []model.SignerRole{{Name: "Employee"}},
+		CCRoles:     []string{"Accounting"},
+		MergeFields: []model.MergeField{
+			{Name: "Salary", Type: "text"},
+			{Name: "Relocation", Type: "checkbox"},
+		},
+		CanReorderSigners: true,
+	}
+}
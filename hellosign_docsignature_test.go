@@ -1,12 +1,27 @@
 package hellosign
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"github.com/DeputyApp/hellosign-go-sdk/model"
 	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
 	"log"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/dnaeon/go-vcr/cassette"
 	"github.com/dnaeon/go-vcr/recorder"
@@ -31,6 +46,7 @@ func TestCreateEmbeddedSignatureRequestSuccess(t *testing.T) {
 	assert.Equal(t, true, res.TestMode)
 	assert.Equal(t, false, res.IsComplete)
 	assert.Equal(t, false, res.IsDeclined)
+	assert.Equal(t, false, res.HasError)
 }
 
 func TestCreateEmbeddedSignatureRequestSuccess2(t *testing.T) {
@@ -69,134 +85,1776 @@ func TestCreateEmbeddedSignatureRequestMissingSigners(t *testing.T) {
 	assert.NotNil(t, err, "Should return error")
 
 	assert.Equal(t, err.Error(), "bad_request: Must specify a name for each signer")
+
+	var apiErr *model.APIError
+	require.True(t, errors.As(err, &apiErr), "Should return an APIError")
+	assert.Equal(t, 400, apiErr.GetStatusCode())
+	assert.Equal(t, "bad_request", apiErr.GetErrorName())
+	assert.Equal(t, "Must specify a name for each signer", apiErr.GetErrorMsg())
+}
+func TestCreateEmbeddedSignatureRequestWarnings(t *testing.T) {
+	// Start our recorder
+	vcr := fixture("fixtures/docsignature/embedded_signature_request_warnings")
+
+	client := createVcrClient(vcr)
+
+	embReq := createEmbeddedSignatureRequest()
+
+	res, err := client.CreateEmbeddedSignatureRequest(embReq)
+
+	assert.Nil(t, res, "Should not return response")
+	assert.NotNil(t, err, "Should return error")
+
+	assert.Equal(t, err.Error(), "parameter_missing: field is missing, empty_value: oops")
+}
+
+func TestCreateEmbeddedSignatureRequestResponseWarnings(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/embedded_signature_request_response_warnings")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.CreateEmbeddedSignatureRequest(createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "5e7d0a4fb03c6d9e8f2a4b6cad3e5f7a9b1c2d4e", res.GetSignatureRequestID())
+	require.Len(t, res.GetWarnings(), 2)
+	assert.Equal(t, "deprecated_parameter", res.GetWarnings()[0].GetName())
+	assert.Equal(t, "The parameter 'form_fields_per_document' is deprecated, use 'form_fields' instead", res.GetWarnings()[0].GetMessage())
+	assert.Equal(t, "unassigned_custom_field", res.GetWarnings()[1].GetName())
+}
+
+func TestSignatureRequestResponseWarnings(t *testing.T) {
+	data := &model.SignatureRequestResponse{}
+	err := json.Unmarshal([]byte(`{"signature_request":{},"warnings":[{"warning_msg":"oops","warning_name":"empty_value"}]}`), data)
+	require.Nil(t, err, "Should not return error")
+
+	require.Len(t, data.GetWarnings(), 1)
+	assert.Equal(t, "empty_value", data.GetWarnings()[0].GetName())
+	assert.Equal(t, "oops", data.GetWarnings()[0].GetMessage())
+}
+
+func TestCreateEmbeddedSignatureRequestFileURL(t *testing.T) {
+	// Start our recorder
+	vcr := fixture("fixtures/docsignature/embedded_signature_request_file_url")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	request := model.EmbeddedSignatureRequest{
+		TestMode: true,
+		ClientID: os.Getenv("HELLOSIGN_CLIENT_ID"),
+		FileURL:  []string{"http://www.pdf995.com/samples/pdf.pdf"},
+		Title:    "My First Document",
+		Subject:  "Contract",
+		Signers: []model.Signer{
+			{
+				Email: "jane@example.com",
+				Name:  "Jane Doe",
+			},
+		},
+	}
+
+	res, err := client.CreateEmbeddedSignatureRequest(request)
+	assert.NotNil(t, res, "Should return response")
+	assert.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, "c9af885443fad587aa2a4698086c08c64233df64", res.SignatureRequestID)
+	assert.Equal(t, "My First Document", res.Title)
+	assert.Equal(t, true, res.TestMode)
+	assert.Equal(t, false, res.IsComplete)
+	assert.Equal(t, false, res.IsDeclined)
+}
+
+func TestCreateSignatureRequestSuccess(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/send_signature_request")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.CreateSignatureRequest(createSignatureRequestSendRequest())
+
+	assert.NotNil(t, res, "Should return response")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, "a4e0d2f695b7f3d8f3b64ba96e0e5c9e6bb0e7f2", res.GetSignatureRequestID())
+	assert.Equal(t, "Contract", res.GetSubject())
+	assert.Equal(t, "Please sign this contract", res.GetMessage())
+	assert.Equal(t, "jane@example.com", res.GetSignatures()[0].GetSignerEmailAddress())
+}
+
+func TestSignatureRequestSendRequestMarshalling(t *testing.T) {
+	client := Client{}
+
+	params, writer, err := client.marshalMultipartSignatureRequest(createSignatureRequestSendRequest())
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	assert.NotContains(t, form.Value, ClientIDKey)
+	assert.Equal(t, []string{"Contract"}, form.Value[SubjectKey])
+	assert.Equal(t, []string{"Please sign this contract"}, form.Value[MessageKey])
+	assert.Equal(t, []string{"jane@example.com"}, form.Value["signers[0][email_address]"])
+}
+
+func TestSignatureRequestMetadataRoundTrip(t *testing.T) {
+	var sent map[string][]string
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		sent = readRequestForm(t, r).Value
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"abc123","metadata":{"order_id":"ORD-1042","tenant":"deputy"}}}`), nil
+	})
+
+	req := createSignatureRequestSendRequest()
+	req.Metadata = map[string]string{
+		"order_id": "ORD-1042",
+		"tenant":   "deputy",
+	}
+
+	res, err := client.CreateSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, []string{"ORD-1042"}, sent["metadata[order_id]"])
+	assert.Equal(t, []string{"deputy"}, sent["metadata[tenant]"])
+	assert.Equal(t, req.Metadata, res.GetMetadata())
+}
+
+func TestForceTestModeMarshalling(t *testing.T) {
+	client := Client{ForceTestMode: true}
+
+	req := createSignatureRequestSendRequest()
+	req.TestMode = false
+
+	params, writer, err := client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"1"}, readMultipartForm(t, params, writer).Value[TestModeKey])
+
+	templateReq := createSignatureRequestSendWithTemplateRequest()
+	templateReq.TestMode = false
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Applicant",
+		},
+	}
+
+	params, writer, err = client.marshalMultipartSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"1"}, readMultipartForm(t, params, writer).Value[TestModeKey])
+
+	params, writer, err = client.marshalMultipartCreateEmbeddedTemplateRequest(model.CreateEmbeddedTemplateRequest{TestMode: false})
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"1"}, readMultipartForm(t, params, writer).Value[TestModeKey])
+
+	client.ForceTestMode = false
+	params, writer, err = client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"0"}, readMultipartForm(t, params, writer).Value[TestModeKey], "Should respect the request otherwise")
+}
+
+func TestSignerSMSPhoneNumberMarshalling(t *testing.T) {
+	client := Client{}
+
+	req := createSignatureRequestSendRequest()
+	req.Signers = []model.Signer{
+		{
+			Email:              "jane@example.com",
+			Name:               "Jane Doe",
+			SMSPhoneNumber:     "+14155550100",
+			SMSPhoneNumberType: model.SMSPhoneNumberTypeDelivery,
+		},
+		{
+			Email: "john@example.com",
+			Name:  "John Doe",
+		},
+	}
+
+	params, writer, err := client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"+14155550100"}, form.Value["signers[0][sms_phone_number]"])
+	assert.Equal(t, []string{"delivery"}, form.Value["signers[0][sms_phone_number_type]"])
+	assert.NotContains(t, form.Value, "signers[1][sms_phone_number]")
+	assert.NotContains(t, form.Value, "signers[1][sms_phone_number_type]")
+}
+
+func TestSignerLanguageMarshalling(t *testing.T) {
+	client := Client{}
+
+	req := createSignatureRequestSendRequest()
+	req.Signers = []model.Signer{
+		{Email: "jane@example.com", Name: "Jane Doe", Language: model.LanguageFrench},
+		{Email: "john@example.com", Name: "John Doe"},
+	}
+
+	params, writer, err := client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"fr-FR"}, form.Value["signers[0][language]"])
+	assert.NotContains(t, form.Value, "signers[1][language]")
+
+	tplReq := createSignatureRequestSendWithTemplateRequest()
+	tplReq.Signers[0].Language = model.LanguageGerman
+
+	params, writer, err = client.marshalMultipartSignatureWithTemplateRequest(tplReq, []model.SignerRole{{Name: "Employee"}})
+	require.Nil(t, err, "Should not return error")
+	form = readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"de-DE"}, form.Value["signers[Employee][language]"])
+}
+
+func TestSignerLanguageRejectsUnsupported(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("Should not call the API")
+		return nil, nil
+	})
+
+	req := createSignatureRequestSendRequest()
+	req.Signers[0].Language = "xx-XX"
+
+	res, err := client.CreateSignatureRequest(req)
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, `unsupported language "xx-XX"`)
+}
+
+func TestIntAndPointerFieldMarshalling(t *testing.T) {
+	client := Client{}
+
+	type scalarRequest struct {
+		Title        string  `form_field:"title"`
+		Count        int     `form_field:"count"`
+		Skipped      int     `form_field:"skipped"`
+		Label        *string `form_field:"label"`
+		EmptyLabel   *string `form_field:"empty_label"`
+		MissingLabel *string `form_field:"missing_label"`
+		Order        *int    `form_field:"order"`
+		Locked       *bool   `form_field:"locked"`
+	}
+
+	label, empty, order, locked := "Offer", "", 0, false
+	req := scalarRequest{
+		Title:      "Offer Letter",
+		Count:      3,
+		Label:      &label,
+		EmptyLabel: &empty,
+		Order:      &order,
+		Locked:     &locked,
+	}
+
+	for name, marshal := range map[string]func(interface{}) (*bytes.Buffer, *multipart.Writer, error){
+		"signature request": client.marshalMultipartSignatureRequest,
+		"template request": func(request interface{}) (*bytes.Buffer, *multipart.Writer, error) {
+			return client.marshalMultipartSignatureWithTemplateRequest(request, nil)
+		},
+	} {
+		params, writer, err := marshal(req)
+		require.Nil(t, err, "Should not return error for a %s", name)
+		form := readMultipartForm(t, params, writer)
+
+		assert.Equal(t, []string{"Offer Letter"}, form.Value["title"])
+		assert.Equal(t, []string{"3"}, form.Value["count"], "Should format int fields for a %s", name)
+		assert.NotContains(t, form.Value, "skipped", "Should omit zero int fields for a %s", name)
+		assert.Equal(t, []string{"Offer"}, form.Value["label"], "Should dereference pointer fields for a %s", name)
+		assert.Equal(t, []string{""}, form.Value["empty_label"], "Should send a set pointer even when empty for a %s", name)
+		assert.NotContains(t, form.Value, "missing_label", "Should omit nil pointer fields for a %s", name)
+		assert.Equal(t, []string{"0"}, form.Value["order"])
+		assert.Equal(t, []string{"0"}, form.Value["locked"])
+	}
+}
+
+func TestSignerOrderMarshalling(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.Signers = []model.Signer{
+		{Email: "jane@example.com", Name: "Jane Doe", Order: 0},
+		{Email: "john@example.com", Name: "John Doe", Order: 1},
+	}
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"0"}, form.Value["signers[0][order]"], "Should send order 0 once the signers are ordered")
+	assert.Equal(t, []string{"1"}, form.Value["signers[1][order]"])
+}
+
+func TestSignerOrderRejectsDuplicates(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("Should not call the API")
+		return nil, nil
+	})
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.Signers = []model.Signer{
+		{Email: "jane@example.com", Name: "Jane Doe", Order: 1},
+		{Email: "john@example.com", Name: "John Doe", Order: 2},
+		{Email: "jack@example.com", Name: "Jack Doe", Order: 1},
+	}
+
+	res, err := client.CreateEmbeddedSignatureRequest(embReq)
+	assert.Nil(t, res, "Should not return response")
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, "signers[0] and signers[2] have the same order: 1", err.Error())
+
+	embReq.Signers[2].Order = -1
+	_, err = client.CreateEmbeddedSignatureRequest(embReq)
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, "signers[2] has a negative order: -1", err.Error())
+}
+
+func TestAllowDeclineMarshalling(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.AllowDecline = true
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"1"}, readMultipartForm(t, params, writer).Value["allow_decline"])
+
+	templateReq := createEmbeddedSignatureWithTemplateRequest("c26b8a16784a872da37ea946b9ddec7c1e11dff6")
+	templateReq.AllowDecline = true
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Client",
+		},
+	}
+
+	params, writer, err = client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"1"}, readMultipartForm(t, params, writer).Value["allow_decline"])
+}
+
+func TestTemplateSignerPinMarshalling(t *testing.T) {
+	client := Client{}
+
+	req := createSignatureRequestSendWithTemplateRequest()
+	req.Signers = []model.Signer{
+		{Email: "jane@example.com", Name: "Jane Doe", Pin: "4321"},
+		{Email: "john@example.com", Name: "John Doe"},
+	}
+	signerRoles := []model.SignerRole{{Name: "Employee"}, {Name: "Manager"}}
+
+	params, writer, err := client.marshalMultipartSignatureWithTemplateRequest(req, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"4321"}, form.Value["signers[Employee][pin]"], "Should key the pin by role name")
+	assert.NotContains(t, form.Value, "signers[0][pin]")
+	assert.NotContains(t, form.Value, "signers[Manager][pin]")
+}
+
+func TestQualifiedSignatureMarshalling(t *testing.T) {
+	client := Client{}
+
+	req := createSignatureRequestSendRequest()
+	params, writer, err := client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"0"}, form.Value["is_qualified_signature"])
+	assert.Equal(t, []string{"0"}, form.Value["is_eid"])
+
+	req.IsQualifiedSignature = true
+	req.IsEID = true
+	params, writer, err = client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+	form = readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"1"}, form.Value["is_qualified_signature"])
+	assert.Equal(t, []string{"1"}, form.Value["is_eid"])
+
+	templateReq := createSignatureRequestSendWithTemplateRequest()
+	templateReq.IsQualifiedSignature = true
+	templateReq.IsEID = true
+	params, writer, err = client.marshalMultipartSignatureWithTemplateRequest(templateReq, []model.SignerRole{{Name: "Employee"}})
+	require.Nil(t, err, "Should not return error")
+	form = readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"1"}, form.Value["is_qualified_signature"])
+	assert.Equal(t, []string{"1"}, form.Value["is_eid"])
+}
+
+func TestPopulateAutoFillFieldsMarshalling(t *testing.T) {
+	client := Client{}
+
+	req := createSignatureRequestSendRequest()
+	params, writer, err := client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"0"}, form.Value["populate_auto_fill_fields"])
+
+	req.PopulateAutoFillFields = true
+	params, writer, err = client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should not return error")
+	form = readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"1"}, form.Value["populate_auto_fill_fields"])
+
+	embeddedReq := createEmbeddedSignatureRequest()
+	embeddedReq.PopulateAutoFillFields = true
+	params, writer, err = client.marshalMultipartEmbeddedSignatureRequest(embeddedReq)
+	require.Nil(t, err, "Should not return error")
+	form = readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"1"}, form.Value["populate_auto_fill_fields"])
+}
+
+func TestAllowReassignMarshalling(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	assert.NotContains(t, readMultipartForm(t, params, writer).Value, "allow_reassign", "Should omit allow_reassign when false")
+
+	embReq.AllowReassign = true
+	params, writer, err = client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"1"}, readMultipartForm(t, params, writer).Value["allow_reassign"])
+
+	templateReq := createEmbeddedSignatureWithTemplateRequest("c26b8a16784a872da37ea946b9ddec7c1e11dff6")
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Client",
+		},
+	}
+
+	params, writer, err = client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.NotContains(t, readMultipartForm(t, params, writer).Value, "allow_reassign", "Should omit allow_reassign when false")
+
+	templateReq.AllowReassign = true
+	params, writer, err = client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"1"}, readMultipartForm(t, params, writer).Value["allow_reassign"])
+}
+
+func TestEmbeddedSignatureWithTemplateFormFieldsPerDocumentMarshalling(t *testing.T) {
+	client := Client{}
+
+	templateReq := createEmbeddedSignatureWithTemplateRequest("c26b8a16784a872da37ea946b9ddec7c1e11dff6")
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Client",
+		},
+	}
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.NotContains(t, readMultipartForm(t, params, writer).Value, FormFieldsPerDocKey, "Should omit form_fields_per_document when empty")
+
+	templateReq.FormFieldsPerDocument = [][]model.DocumentFormField{
+		{
+			{APIId: "api_id", Name: "display name", Type: "text", X: 123, Y: 456, Width: 678, Required: true, Signer: 0},
+		},
+	}
+
+	params, writer, err = client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t,
+		[]string{`[[{"api_id":"api_id","name":"display name","type":"text","x":123,"y":456,"width":678,"height":0,"required":true,"signer":0}]]`},
+		readMultipartForm(t, params, writer).Value[FormFieldsPerDocKey],
+	)
+}
+
+func TestFormFieldBuilders(t *testing.T) {
+	builders := map[string]func(string, int, int, int, int, int, int) (model.DocumentFormField, error){
+		"text":        model.NewTextField,
+		"signature":   model.NewSignatureField,
+		"checkbox":    model.NewCheckboxField,
+		"date_signed": model.NewDateField,
+	}
+
+	for fieldType, build := range builders {
+		field, err := build("field_1", 10, 20, 120, 30, 2, 1)
+		require.Nil(t, err, "Should not return error")
+
+		fieldJSON, err := json.Marshal(field)
+		require.Nil(t, err)
+
+		var keys map[string]interface{}
+		require.Nil(t, json.Unmarshal(fieldJSON, &keys))
+		for _, key := range []string{"api_id", "name", "type", "x", "y", "width", "height", "required", "signer", "page"} {
+			assert.Contains(t, keys, key, "Should emit %s for a %s field", key, fieldType)
+		}
+		assert.Equal(t, fieldType, keys["type"])
+		assert.Equal(t, "field_1", keys["api_id"])
+		assert.Equal(t, float64(2), keys["page"])
+		assert.Equal(t, float64(1), keys["signer"])
+		assert.Equal(t, fieldType == "signature", keys["required"], "Should only require signature fields")
+	}
+}
+
+func TestFormFieldBuildersValidate(t *testing.T) {
+	_, err := model.NewFormField("stamp", "field_1", 10, 20, 120, 30, 1, 0)
+	assert.EqualError(t, err, `unknown form field type "stamp"`)
+
+	_, err = model.NewTextField("", 10, 20, 120, 30, 1, 0)
+	assert.EqualError(t, err, "form field api_id is required")
+
+	_, err = model.NewTextField("field_1", -1, 20, 120, 30, 1, 0)
+	assert.EqualError(t, err, "form field field_1 must have a non-negative position, got x=-1 y=20")
+
+	_, err = model.NewSignatureField("field_1", 10, 20, 0, 30, 1, 0)
+	assert.EqualError(t, err, "form field field_1 must have a positive size, got width=0 height=30")
+
+	_, err = model.NewCheckboxField("field_1", 10, 20, 14, 14, 0, 0)
+	assert.EqualError(t, err, "form field field_1 has an invalid page: 0")
+
+	_, err = model.NewDateField("field_1", 10, 20, 100, 15, 1, -1)
+	assert.EqualError(t, err, "form field field_1 has a negative signer: -1")
+}
+
+func TestGetSignatureRequestDeclined(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_signature_request_declined")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetSignatureRequest("8e1f3a5c7b9d2e4f6a8c0b1d3e5f7a9c2b4d6e8f")
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.True(t, res.GetIsDeclined())
+	assert.Equal(t, "Rate is incorrect", res.GetDeclineReason(), "Should surface the signer's decline reason")
+
+	require.Len(t, res.DeclinedSigners(), 1)
+	declined := res.DeclinedSigners()[0]
+	assert.Equal(t, "contractor@example.com", declined.GetSignerEmailAddress())
+	assert.Equal(t, "Rate is incorrect", declined.GetDeclineReason())
+
+	assert.Equal(t, "", (&model.SignatureRequest{}).GetDeclineReason(), "Should be empty when no one declined")
+}
+
+func TestGetSignatureRequestReassigned(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_signature_request_reassigned")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetSignatureRequest("7a9c2e4f6b8d0a1c3e5f7b9d1a3c5e7f9b0d2e4f")
+	require.Nil(t, err, "Should not return error")
+	require.Len(t, res.GetSignatures(), 1)
+
+	signature := res.GetSignatures()[0]
+	assert.Equal(t, "colleague@example.com", signature.GetSignerEmailAddress())
+	assert.Equal(t, "original@example.com", signature.GetReassignedBy())
+	assert.Equal(t, "On leave this week", signature.GetReassignmentReason())
+}
+
+func TestExpiresAtMarshalling(t *testing.T) {
+	client := Client{}
+	expiresAt := time.Now().Add(14 * 24 * time.Hour).Unix()
+
+	embReq := createEmbeddedSignatureRequest()
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	assert.Nil(t, readMultipartForm(t, params, writer).Value["expires_at"], "Should omit expires_at when not set")
+
+	embReq.ExpiresAt = expiresAt
+	params, writer, err = client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{strconv.FormatInt(expiresAt, 10)}, readMultipartForm(t, params, writer).Value["expires_at"])
+
+	templateReq := createSignatureRequestSendWithTemplateRequest()
+	templateReq.ExpiresAt = expiresAt
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Client",
+		},
+	}
+
+	params, writer, err = client.marshalMultipartSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{strconv.FormatInt(expiresAt, 10)}, readMultipartForm(t, params, writer).Value["expires_at"])
+}
+
+func TestExpiresAtRejectsPastTime(t *testing.T) {
+	client := Client{}
+
+	sendReq := createSignatureRequestSendRequest()
+	sendReq.ExpiresAt = 1505246717
+
+	_, _, err := client.marshalMultipartSignatureRequest(sendReq)
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, "expires_at must be in the future: 2017-09-12T20:05:17Z", err.Error())
+}
+
+func TestSigningOptionsMarshalling(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.SigningOptions = &model.SigningOptions{
+		Draw:        true,
+		Type:        true,
+		Upload:      false,
+		Phone:       true,
+		DefaultType: "draw",
+	}
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"1"}, form.Value["signing_options[draw]"])
+	assert.Equal(t, []string{"1"}, form.Value["signing_options[type]"])
+	assert.Equal(t, []string{"0"}, form.Value["signing_options[upload]"])
+	assert.Equal(t, []string{"1"}, form.Value["signing_options[phone]"])
+	assert.Equal(t, []string{"draw"}, form.Value["signing_options[default_type]"])
+
+	embReq.SigningOptions.DefaultType = ""
+	params, writer, err = client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	assert.NotContains(t, readMultipartForm(t, params, writer).Value, "signing_options[default_type]")
+}
+
+func TestFieldOptionsMarshalling(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.FieldOptions = &model.FieldOptions{DateFormat: model.DateFormatDayMonthYearSlash}
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"DD / MM / YYYY"}, readMultipartForm(t, params, writer).Value["field_options[date_format]"])
+
+	templateReq := createEmbeddedSignatureWithTemplateRequest("c26b8a16784a872da37ea946b9ddec7c1e11dff6")
+	templateReq.FieldOptions = &model.FieldOptions{DateFormat: model.DateFormatYearMonthDayDash}
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Client",
+		},
+	}
+
+	params, writer, err = client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"YYYY - MM - DD"}, readMultipartForm(t, params, writer).Value["field_options[date_format]"])
+}
+
+func TestAttachmentsMarshalling(t *testing.T) {
+	client := Client{}
+
+	attachments := []model.Attachment{
+		{
+			Name:         "Driver's License",
+			SignerIndex:  0,
+			Instructions: "Upload a photo of the front of your license",
+			Required:     true,
+		},
+		{
+			Name:        "Proof of Address",
+			SignerIndex: 1,
+		},
+	}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.Attachments = attachments
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"Driver's License"}, form.Value["attachments[0][name]"])
+	assert.Equal(t, []string{"0"}, form.Value["attachments[0][signer_index]"])
+	assert.Equal(t, []string{"Upload a photo of the front of your license"}, form.Value["attachments[0][instructions]"])
+	assert.Equal(t, []string{"1"}, form.Value["attachments[0][required]"])
+	assert.Equal(t, []string{"Proof of Address"}, form.Value["attachments[1][name]"])
+	assert.Equal(t, []string{"1"}, form.Value["attachments[1][signer_index]"])
+	assert.Nil(t, form.Value["attachments[1][instructions]"], "Should omit empty instructions")
+	assert.Equal(t, []string{"0"}, form.Value["attachments[1][required]"])
+
+	templateReq := createEmbeddedSignatureWithTemplateRequest("c26b8a16784a872da37ea946b9ddec7c1e11dff6")
+	templateReq.Attachments = attachments
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Client",
+		},
+	}
+
+	params, writer, err = client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	form = readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"Driver's License"}, form.Value["attachments[0][name]"])
+	assert.Equal(t, []string{"Proof of Address"}, form.Value["attachments[1][name]"])
+}
+
+func TestEmbeddedSignatureWithTemplateSigningRedirectURLMarshalling(t *testing.T) {
+	client := Client{}
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Client",
+		},
+	}
+
+	templateReq := createEmbeddedSignatureWithTemplateRequest("c26b8a16784a872da37ea946b9ddec7c1e11dff6")
+	params, writer, err := client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.NotContains(t, readMultipartForm(t, params, writer).Value, "signing_redirect_url")
+
+	templateReq.SigningRedirectURL = "https://www.example.com/signed"
+	params, writer, err = client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"https://www.example.com/signed"}, readMultipartForm(t, params, writer).Value["signing_redirect_url"])
+}
+
+func TestEmbeddedSignatureWithTemplateCCsMarshalling(t *testing.T) {
+	client := Client{}
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Client",
+		},
+	}
+
+	templateReq := createEmbeddedSignatureWithTemplateRequest("c26b8a16784a872da37ea946b9ddec7c1e11dff6")
+	templateReq.CCEmailAddresses = []string{"legal@example.com"}
+	templateReq.CCs = []model.CCRole{
+		{
+			Name:  "Accounting",
+			Email: "accounting@example.com",
+		},
+	}
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureWithTemplateRequest(templateReq, signerRoles)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"accounting@example.com"}, form.Value["ccs[Accounting][email_address]"])
+	assert.Equal(t, []string{"legal@example.com"}, form.Value["cc_email_addresses[0]"])
+}
+
+func TestFieldOptionsRejectsUnknownDateFormat(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.FieldOptions = &model.FieldOptions{DateFormat: "DD.MM.YYYY"}
+
+	_, _, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, `unknown date_format "DD.MM.YYYY"`, err.Error())
+}
+
+func TestEmbeddedSignatureRequestCustomFieldsMarshalling(t *testing.T) {
+	client := Client{}
+
+	editor := "Client"
+	embReq := createEmbeddedSignatureRequest()
+	embReq.CustomFields = []model.CustomField{
+		{
+			Name:     "Cost",
+			Value:    "$20,000",
+			Editor:   &editor,
+			Required: true,
+		},
+		{
+			Name:  "Approved",
+			Value: true,
+		},
+	}
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+	require.Len(t, form.Value[CustomFieldsKey], 1)
+
+	var customFields []map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(form.Value[CustomFieldsKey][0]), &customFields), "Should send a JSON array")
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "Cost", "value": "$20,000", "editor": "Client", "required": true},
+		{"name": "Approved", "value": true, "required": false},
+	}, customFields)
+}
+
+func TestTextTagsMarshalling(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.UseTextTags = true
+	embReq.HideTextTags = true
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"1"}, form.Value["use_text_tags"])
+	assert.Equal(t, []string{"1"}, form.Value["hide_text_tags"])
+	assert.Equal(t, []string{"freddy@hellosign.com"}, form.Value["signers[0][email_address]"], "Should still send signers")
+
+	embReq.UseTextTags = false
+	embReq.HideTextTags = false
+
+	params, writer, err = client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+
+	form = readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"0"}, form.Value["use_text_tags"])
+	assert.Equal(t, []string{"0"}, form.Value["hide_text_tags"])
+}
+
+func TestHideTextTagsRequiresUseTextTags(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("Should not call the API")
+		return nil, nil
+	})
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.UseTextTags = false
+	embReq.HideTextTags = true
+
+	res, err := client.CreateEmbeddedSignatureRequest(embReq)
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, "hide_text_tags requires use_text_tags, HelloSign only hides text tags it is parsing")
+
+	req := createSignatureRequestSendRequest()
+	req.HideTextTags = true
+	_, _, err = client.marshalMultipartSignatureRequest(req)
+	assert.NotNil(t, err, "Should validate send requests too")
+
+	req.UseTextTags = true
+	_, _, err = client.marshalMultipartSignatureRequest(req)
+	assert.Nil(t, err, "Should accept hide_text_tags with use_text_tags")
+}
+
+func TestEmbeddedSignatureRequestFileUploadMarshalling(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.File = []string{"fixtures/offer_letter.pdf"}
+	embReq.FileUploads = []model.FileUpload{
+		{
+			Name:   "generated.pdf",
+			Reader: bytes.NewBufferString("%PDF-1.4 generated on the fly"),
+		},
+	}
+
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	require.Len(t, form.File["file[0]"], 1)
+	assert.Equal(t, "offer_letter.pdf", filepath.Base(form.File["file[0]"][0].Filename))
+
+	require.Len(t, form.File["file[1]"], 1)
+	upload := form.File["file[1]"][0]
+	assert.Equal(t, "generated.pdf", upload.Filename)
+
+	file, err := upload.Open()
+	require.Nil(t, err, "Should open uploaded part")
+	defer file.Close()
+	contents, err := ioutil.ReadAll(file)
+	require.Nil(t, err, "Should read uploaded part")
+	assert.Equal(t, "%PDF-1.4 generated on the fly", string(contents))
+}
+
+func TestFileURLValidation(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("Should not call the API")
+		return nil, nil
+	})
+
+	req := createSignatureRequestSendRequest()
+	req.File = nil
+	req.FileURL = []string{"https://www.pdf995.com/samples/pdf.pdf", "samples/pdf.pdf"}
+
+	res, err := client.CreateSignatureRequest(req)
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, `file_url[1] is not an absolute http(s) URL: "samples/pdf.pdf"`)
+
+	for _, fileURL := range []string{"ftp://www.pdf995.com/samples/pdf.pdf", "https://", "http://www.pdf995.com/%zz"} {
+		req.FileURL = []string{fileURL}
+		_, err = client.CreateSignatureRequest(req)
+		assert.NotNil(t, err, "Should reject %s", fileURL)
+	}
+
+	req.FileURL = []string{"https://www.pdf995.com/samples/pdf.pdf", "http://www.pdf995.com/samples/pdf2.pdf"}
+	params, writer, err := client.marshalMultipartSignatureRequest(req)
+	require.Nil(t, err, "Should accept absolute http(s) URLs")
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"http://www.pdf995.com/samples/pdf2.pdf"}, form.Value["file_url[1]"])
+}
+
+func TestFileAndFileURLAreMutuallyExclusive(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.FileURL = []string{"http://www.pdf995.com/samples/pdf.pdf"}
+
+	_, _, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, "file and file_url cannot be used together, provide the documents as either files or file URLs", err.Error())
+
+	embReq.File = nil
+	embReq.FileUploads = []model.FileUpload{{Name: "offer_letter.pdf", Reader: strings.NewReader("%PDF-1.4")}}
+	_, _, err = client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	assert.NotNil(t, err, "Should reject file uploads with file_url")
+
+	embReq.FileUploads = nil
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(embReq)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{"http://www.pdf995.com/samples/pdf.pdf"}, readMultipartForm(t, params, writer).Value["file_url[0]"])
+
+	params, writer, err = client.marshalMultipartEmbeddedSignatureRequest(createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+	assert.Len(t, readMultipartForm(t, params, writer).File["file[1]"], 1)
+
+	_, _, err = client.marshalMultipartCreateEmbeddedTemplateRequest(model.CreateEmbeddedTemplateRequest{
+		File:    []string{"fixtures/offer_letter.pdf"},
+		FileURL: []string{"http://www.pdf995.com/samples/pdf.pdf"},
+	})
+	assert.NotNil(t, err, "Should reject embedded templates with both")
+}
+
+func TestCreateEmbeddedSignatureRequestMissingFile(t *testing.T) {
+	client := Client{}
+
+	embReq := createEmbeddedSignatureRequest()
+	embReq.File = []string{"fixtures/does_not_exist.pdf"}
+
+	res, err := client.CreateEmbeddedSignatureRequest(embReq)
+
+	assert.Nil(t, res, "Should not return response")
+	require.NotNil(t, err, "Should return error")
+	assert.True(t, os.IsNotExist(err), "Should return a file not found error")
+	assert.Contains(t, err.Error(), "fixtures/does_not_exist.pdf")
+}
+
+func TestGetSignatureRequest(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_signature_request")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetSignatureRequest("6d7ad140141a7fe6874fec55931c363e0301c353")
+
+	assert.NotNil(t, res, "Should return response")
+	assert.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, "6d7ad140141a7fe6874fec55931c363e0301c353", res.SignatureRequestID)
+	assert.Equal(t, "awesome", res.Subject)
+	assert.Equal(t, true, res.TestMode)
+	assert.Equal(t, false, res.IsComplete)
+	assert.Equal(t, false, res.IsDeclined)
+}
+
+func TestSignatureRequestStatusHelpers(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_signature_request_mixed_status")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetSignatureRequest("2f9781e1a8e2045224d808c153c2e1d3df6f8f2f")
+	require.Nil(t, err, "Should not return error")
+	require.Len(t, res.GetSignatures(), 4)
+
+	assert.True(t, res.GetSignatures()[0].IsSigned())
+	assert.False(t, res.GetSignatures()[0].IsPending())
+
+	pending := res.PendingSigners()
+	require.Len(t, pending, 2)
+	assert.Equal(t, "waiting@example.com", pending[0].GetSignerEmailAddress())
+	assert.Equal(t, model.SignatureStatusOnHold, pending[1].GetStatusCode())
+
+	declined := res.DeclinedSigners()
+	require.Len(t, declined, 1)
+	assert.Equal(t, "Wrong entity name", declined[0].GetDeclineReason())
+
+	assert.True(t, res.IsAwaitingSigner("Waiting@example.com"))
+	assert.False(t, res.IsAwaitingSigner("signed@example.com"))
+	assert.False(t, res.IsAwaitingSigner("declined@example.com"))
+}
+
+func TestGetSignatureRequestRaw(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		response := stubResponse(200, `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353","is_complete":true}}`)
+		response.Header.Set("X-Request-Id", "b5c1e3a94f7d")
+		return response, nil
+	})
+
+	res, response, err := client.GetSignatureRequestRaw("6d7ad140141a7fe6874fec55931c363e0301c353")
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+	require.NotNil(t, response, "Should return the http response")
+
+	assert.Equal(t, "6d7ad140141a7fe6874fec55931c363e0301c353", res.GetSignatureRequestID())
+	assert.True(t, res.GetIsComplete())
+	assert.Equal(t, "b5c1e3a94f7d", response.Header.Get("X-Request-Id"))
+
+	body, err := ioutil.ReadAll(response.Body)
+	require.Nil(t, err, "Should be able to read the body again")
+	assert.Contains(t, string(body), `"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353"`)
+}
+
+func TestCreateEmbeddedSignatureRequestRaw(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/v3/signature_request/create_embedded", r.URL.Path)
+		response := stubResponse(200, `{"signature_request":{"signature_request_id":"a9f4825edef25f47e7b4c14ce8100d81d1693160"}}`)
+		response.Header.Set("X-Ratelimit-Limit-Remaining", "99")
+		return response, nil
+	})
+
+	res, response, err := client.CreateEmbeddedSignatureRequestRaw(createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, "a9f4825edef25f47e7b4c14ce8100d81d1693160", res.GetSignatureRequestID())
+	assert.Equal(t, "99", response.Header.Get("X-Ratelimit-Limit-Remaining"))
+}
+
+func TestMarshalEmbeddedSignatureRequest(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("Should not send the request")
+		return nil, nil
+	})
+
+	contentType, body, err := client.MarshalEmbeddedSignatureRequest(createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	require.Nil(t, err, "Should return a valid content type")
+	assert.Equal(t, "multipart/form-data", mediaType)
+
+	form, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).ReadForm(10 << 20)
+	require.Nil(t, err, "Should parse multipart body")
+
+	assert.Equal(t, []string{"cool title"}, form.Value["title"])
+	assert.Equal(t, []string{"freddy@hellosign.com"}, form.Value["signers[0][email_address]"])
+	assert.Equal(t, []string{"Frederick Rangel"}, form.Value["signers[1][name]"])
+	assert.Equal(t, []string{"1"}, form.Value["test_mode"])
+	require.Len(t, form.File["file[0]"], 1, "Should write the first file part")
+	require.Len(t, form.File["file[1]"], 1, "Should write the second file part")
+	assert.Equal(t, "offer_letter.pdf", form.File["file[0]"][0].Filename)
+}
+
+func TestMarshalEmbeddedSignatureRequestInvalid(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("Should not send the request")
+		return nil, nil
+	})
+
+	request := createEmbeddedSignatureRequest()
+	request.File = []string{"fixtures/missing.pdf"}
+
+	_, body, err := client.MarshalEmbeddedSignatureRequest(request)
+	assert.NotNil(t, err, "Should return error")
+	assert.Nil(t, body, "Should not return body")
+}
+
+func TestMultipartBoundary(t *testing.T) {
+	client := Client{MultipartBoundary: "hellosign-go-sdk-boundary"}
+	request := createEmbeddedSignatureRequest()
+	request.Metadata = map[string]string{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5"}
+
+	contentType, first, err := client.MarshalEmbeddedSignatureRequest(request)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "multipart/form-data; boundary=hellosign-go-sdk-boundary", contentType)
+
+	_, second, err := client.MarshalEmbeddedSignatureRequest(request)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, first, second, "Should marshal the same request to the same bytes")
+
+	client.MultipartBoundary = "not a valid boundary "
+	_, _, err = client.MarshalEmbeddedSignatureRequest(request)
+	assert.NotNil(t, err, "Should reject an invalid boundary")
+}
+
+func TestGetSignatureRequestCompleted(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_signature_request_completed")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetSignatureRequest("1c3e5a7b9d0f2a4c6e8b1d3f5a7c9e0b2d4f6a8c")
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.True(t, res.GetIsComplete())
+	assert.False(t, res.GetIsDeclined())
+	assert.False(t, res.GetHasError())
+
+	require.Len(t, res.GetCustomFields(), 1)
+	assert.Equal(t, "Salary", res.GetCustomFields()[0]["name"])
+	assert.Equal(t, "120000", res.GetCustomFields()[0]["value"])
+
+	require.Len(t, res.GetResponseData(), 1)
+	assert.Equal(t, "a97c8e_3", res.GetResponseData()[0].GetApiID())
+}
+
+func TestGetSignatureRequestError(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_signature_request_error")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetSignatureRequest("3e5a7c9e1b2d4f6a8c0e2b4d6f8a0c1e3f5b7d9a")
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.True(t, res.GetHasError())
+	assert.False(t, res.GetIsComplete())
+	assert.False(t, res.GetIsDeclined())
+	assert.Empty(t, res.GetCustomFields())
+	assert.Empty(t, res.GetResponseData())
+}
+
+func TestGetSignatureRequestResponseData(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_signature_request_response_data")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetSignatureRequest("8b0d3f5a7c9e1b2d4f6a8c0e2b4d6f8a0c1e3f5b")
+	require.Nil(t, err, "Should not return error")
+	require.Len(t, res.GetResponseData(), 3)
+
+	text := res.GetResponseData()[0]
+	assert.Equal(t, "a97c8e_1", text.GetApiID())
+	assert.Equal(t, "e5c5da05c25b8f5fcd5c4a9fd2b1a0e9", text.GetSignatureID())
+	assert.Equal(t, "Tax File Number", text.GetName())
+	assert.Equal(t, "123 456 782", text.GetValue())
+	assert.Equal(t, "text", text.GetType())
+	assert.True(t, text.GetRequired())
+
+	checked := res.GetResponseData()[1]
+	assert.Equal(t, "checkbox", checked.GetType())
+	assert.Equal(t, "true", checked.GetValue())
+	assert.False(t, checked.GetRequired())
+
+	assert.Equal(t, "false", res.GetResponseData()[2].GetValue())
+}
+
+func TestGetSignatureRequestSignerEvents(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_signature_request_signer_events")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetSignatureRequest("3c5b8e2d9f1a4b7c6d0e2f4a8b1c3d5e7f9a0b2c")
+	require.Nil(t, err, "Should not return error")
+	require.Len(t, res.GetSignatures(), 2)
+
+	signed := res.GetSignatures()[0]
+	assert.Equal(t, model.SignatureStatusSigned, signed.GetStatusCode())
+	assert.Equal(t, 1505246717, signed.GetSignedAt())
+	assert.Equal(t, time.Unix(1505246717, 0), signed.GetSignedAtTime())
+	assert.Equal(t, time.Unix(1505246500, 0), signed.GetLastViewedAtTime())
+	assert.Equal(t, time.Unix(1505246100, 0), signed.GetLastRemindedAtTime())
+	assert.Equal(t, "", signed.GetDeclineReason())
+	assert.Nil(t, signed.GetError())
+
+	declined := res.GetSignatures()[1]
+	assert.Equal(t, model.SignatureStatusDeclined, declined.GetStatusCode())
+	assert.Equal(t, "Wrong start date", declined.GetDeclineReason())
+	assert.Equal(t, 0, declined.GetSignedAt())
+	assert.True(t, declined.GetSignedAtTime().IsZero(), "Should be the zero time when not signed")
+	assert.Equal(t, 1505247300, declined.GetLastViewedAt())
+	assert.Equal(t, 1505246100, declined.GetLastRemindedAt())
+	assert.Nil(t, declined.GetError())
+}
+
+func TestSignatureRequestPendingSignersEmpty(t *testing.T) {
+	var res *model.SignatureRequest
+
+	assert.NotNil(t, res.PendingSigners(), "Should return an empty slice")
+	assert.Len(t, res.PendingSigners(), 0)
+}
+
+func TestWaitForCompletion(t *testing.T) {
+	polls := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		polls++
+		assert.Equal(t, "/v3/signature_request/6d7ad140141a7fe6874fec55931c363e0301c353", r.URL.Path)
+		if polls < 3 {
+			return stubResponse(200, `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353","is_complete":false}}`), nil
+		}
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353","is_complete":true}}`), nil
+	})
+
+	res, err := client.WaitForCompletion(context.Background(), "6d7ad140141a7fe6874fec55931c363e0301c353", time.Millisecond)
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.True(t, res.GetIsComplete())
+	assert.Equal(t, 3, polls)
+}
+
+func TestWaitForCompletionDeclined(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353","is_complete":false,"is_declined":true}}`), nil
+	})
+
+	res, err := client.WaitForCompletion(context.Background(), "6d7ad140141a7fe6874fec55931c363e0301c353", time.Millisecond)
+	assert.Equal(t, ErrSignatureRequestDeclined, err)
+	require.NotNil(t, res, "Should return the declined request")
+	assert.True(t, res.GetIsDeclined())
+}
+
+func TestWaitForCompletionCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		cancel()
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353","is_complete":false}}`), nil
+	})
+
+	res, err := client.WaitForCompletion(ctx, "6d7ad140141a7fe6874fec55931c363e0301c353", time.Hour)
+	assert.Nil(t, res, "Should not return response")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestWaitForCompletionCancelledDuringPoll(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		select {
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		case <-time.After(time.Minute):
+			return stubResponse(200, `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353","is_complete":true}}`), nil
+		}
+	})
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	started := time.Now()
+	res, err := client.WaitForCompletion(ctx, "6d7ad140141a7fe6874fec55931c363e0301c353", time.Millisecond)
+	assert.Nil(t, res, "Should not return response")
+	assert.True(t, errors.Is(err, context.Canceled), "Should return context.Canceled")
+	assert.True(t, time.Since(started) < time.Second, "Should abort the poll in flight")
+}
+
+func TestWaitForCompletionAlreadyCancelled(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("Should not poll")
+		return nil, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := client.WaitForCompletion(ctx, "6d7ad140141a7fe6874fec55931c363e0301c353", time.Millisecond)
+	assert.Nil(t, res, "Should not return response")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestWaitForCompletionInvalidInterval(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("Should not poll")
+		return nil, nil
+	})
+
+	res, err := client.WaitForCompletion(context.Background(), "6d7ad140141a7fe6874fec55931c363e0301c353", 0)
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, "invalid argument: interval 0s must be positive")
+}
+
+func TestWaitForCompletionWaitsForRateLimitReset(t *testing.T) {
+	client := Client{}
+	client.lastRateLimit.Store(&model.RateLimit{Limit: 2000, Remaining: 0, Reset: int(time.Now().Add(time.Minute).Unix())})
+
+	assert.True(t, client.pollDelay(time.Second) > 30*time.Second, "Should wait for the rate limit window to reset")
+
+	client.lastRateLimit.Store(&model.RateLimit{Limit: 2000, Remaining: 10, Reset: int(time.Now().Add(time.Minute).Unix())})
+	assert.Equal(t, time.Second, client.pollDelay(time.Second))
+}
+
+func TestGetSignatureRequests(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/list_signature_requests")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.ListSignatureRequests()
+
+	assert.NotNil(t, res, "Should return response")
+	assert.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, 1, res.ListInfo.NumPages)
+	assert.Equal(t, 1, res.ListInfo.Page)
+	assert.Equal(t, 19, res.ListInfo.NumResults)
+	assert.Equal(t, 20, res.ListInfo.PageSize)
+
+	assert.Equal(t, 19, len(res.SignatureRequests))
+}
+
+func TestListSignatureRequestsWithParams(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/list_signature_requests_page_2")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	// the cassette only matches signature_request/list?page=2&page_size=2
+	res, err := client.ListSignatureRequestsWithParams(model.ListParams{Page: 2, PageSize: 2})
+
+	assert.NotNil(t, res, "Should return response")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, 2, res.GetListInfo().GetPage())
+	assert.Equal(t, 2, res.GetListInfo().GetNumPages())
+	assert.Equal(t, 1, len(res.GetSignatureRequests()))
+	assert.Equal(t, "c9af885443fad587aa2a4698086c08c64233df64", res.GetSignatureRequests()[0].GetSignatureRequestID())
+}
+
+func TestIterateSignatureRequests(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/iterate_signature_requests")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	it := client.IterateSignatureRequests(model.ListParams{PageSize: 2})
+
+	ids := []string{}
+	for {
+		sigRequest, ok, err := it.Next()
+		require.Nil(t, err, "Should not return error")
+		if !ok {
+			break
+		}
+		ids = append(ids, sigRequest.GetSignatureRequestID())
+	}
+
+	assert.Equal(t, []string{
+		"4f5a0c2e8b1d3f6a9c7e0b2d4f6a8c1e3b5d7f9a",
+		"7b2d4f6a8c0e1b3d5f7a9c2e4b6d8f0a1c3e5b7d",
+		"9e1c3b5d7f0a2c4e6b8d1f3a5c7e9b2d4f6a8c0e",
+	}, ids)
+}
+
+func TestIterateSignatureRequestsPageError(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/iterate_signature_requests_error")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	it := client.IterateSignatureRequests(model.ListParams{PageSize: 2})
+
+	for i := 0; i < 2; i++ {
+		sigRequest, ok, err := it.Next()
+		require.Nil(t, err, "Should not return error")
+		assert.True(t, ok, "Should return the first page")
+		assert.NotNil(t, sigRequest, "Should return a signature request")
+	}
+
+	sigRequest, ok, err := it.Next()
+	assert.Nil(t, sigRequest, "Should not return a signature request")
+	assert.False(t, ok, "Should stop iterating")
+	assert.Equal(t, "unknown: An unknown error occurred", err.Error())
+}
+
+func TestFindSignatureRequestsByMetadata(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"list_info":{"page":1,"num_pages":2,"num_results":3,"page_size":2},"signature_requests":[` +
+			`{"signature_request_id":"4f5a0c2e8b1d3f6a9c7e0b2d4f6a8c1e3b5d7f9a","metadata":{"order_id":"A-42"}},` +
+			`{"signature_request_id":"7b2d4f6a8c0e1b3d5f7a9c2e4b6d8f0a1c3e5b7d","metadata":{"order_id":"A-421"}}]}`,
+		"2": `{"list_info":{"page":2,"num_pages":2,"num_results":3,"page_size":2},"signature_requests":[` +
+			`{"signature_request_id":"9e1c3b5d7f0a2c4e6b8d1f3a5c7e9b2d4f6a8c0e","metadata":{"order_id":"A-42"}}]}`,
+	}
+
+	queries := []string{}
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/v3/signature_request/list", r.URL.Path)
+		queries = append(queries, r.URL.RawQuery)
+		return stubResponse(200, pages[r.URL.Query().Get("page")]), nil
+	})
+
+	res, err := client.FindSignatureRequestsByMetadata("order_id", "A-42")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, []string{
+		"page=1&query=metadata%3Aorder_id%3DA-42",
+		"page=2&query=metadata%3Aorder_id%3DA-42",
+	}, queries, "Should search the metadata on every page")
+
+	require.Len(t, res, 2, "Should only return exact matches")
+	assert.Equal(t, "4f5a0c2e8b1d3f6a9c7e0b2d4f6a8c1e3b5d7f9a", res[0].GetSignatureRequestID())
+	assert.Equal(t, "9e1c3b5d7f0a2c4e6b8d1f3a5c7e9b2d4f6a8c0e", res[1].GetSignatureRequestID())
+}
+
+func TestFindSignatureRequestsByMetadataError(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		return stubResponse(500, `{"error":{"error_msg":"An unknown error occurred","error_name":"unknown"}}`), nil
+	})
+
+	res, err := client.FindSignatureRequestsByMetadata("order_id", "A-42")
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, "unknown: An unknown error occurred")
+}
+
+func TestListCompletedSignatureRequests(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"list_info":{"page":1,"num_pages":2,"num_results":6,"page_size":3},"signature_requests":[` +
+			`{"signature_request_id":"a1","is_complete":true,"signatures":[{"signed_at":1791720000},{"signed_at":1791883800}]},` +
+			`{"signature_request_id":"b2","is_complete":true,"signatures":[{"signed_at":1791828000}]},` +
+			`{"signature_request_id":"c3","is_complete":false,"signatures":[{"signed_at":1791875700},{"signed_at":null}]}]}`,
+		"2": `{"list_info":{"page":2,"num_pages":2,"num_results":6,"page_size":3},"signature_requests":[` +
+			`{"signature_request_id":"d4","is_complete":true,"signatures":[{"signed_at":1791931500}]},` +
+			`{"signature_request_id":"e5","is_complete":true,"signatures":[{"signed_at":1791939600}]},` +
+			`{"signature_request_id":"f6","is_complete":true,"signatures":[{"signed_at":1791875700}]}]}`,
+	}
+
+	queries := []string{}
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/v3/signature_request/list", r.URL.Path)
+		queries = append(queries, r.URL.Query().Get("query"))
+		return stubResponse(200, pages[r.URL.Query().Get("page")]), nil
+	})
+
+	since := time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24*time.Hour - time.Second)
+	res, err := client.ListCompletedSignatureRequests(since, until)
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, []string{
+		"complete:true AND created:[* TO 2026-10-13]",
+		"complete:true AND created:[* TO 2026-10-13]",
+	}, queries, "Should search completed requests on every page")
+
+	ids := []string{}
+	for _, sigRequest := range res {
+		ids = append(ids, sigRequest.GetSignatureRequestID())
+	}
+	assert.Equal(t, []string{"f6", "a1", "d4"}, ids, "Should only return requests completed in range, oldest first")
+}
+
+func TestListCompletedSignatureRequestsError(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		return stubResponse(500, `{"error":{"error_msg":"An unknown error occurred","error_name":"unknown"}}`), nil
+	})
+
+	res, err := client.ListCompletedSignatureRequests(time.Now().Add(-24*time.Hour), time.Now())
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, "unknown: An unknown error occurred")
+}
+
+func TestGetEmbeddedSignURL(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_embedded_sign_url")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetEmbeddedSignURL("deaf86bfb33764d9a215a07cc060122d")
+
+	assert.NotNil(t, res, "Should return response")
+	assert.Nil(t, err, "Should not return error")
+
+	assert.Contains(t, res.SignURL, "embeddedSign?signature_id=deaf86bfb33764d9a215a07cc060122d&token=")
+	assert.Equal(t, 1505259198, res.ExpiresAt)
+}
+
+func TestGetEmbeddedSignURLNotReadyRespectsContext(t *testing.T) {
+	attempts := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		return stubResponse(409, `{"error":{"error_msg":"This signature request is still being processed","error_name":"conflict"}}`), nil
+	})
+	client.SignURLRetryPolicy = &RetryPolicy{
+		MaxRetries: 3,
+		Backoff:    func(attempt int) time.Duration { return time.Hour },
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	started := time.Now()
+	res, err := client.WithRequestOptions(RequestOptions{Context: ctx}).GetEmbeddedSignURL("deaf86bfb33764d9a215a07cc060122d")
+	assert.Nil(t, res, "Should not return response")
+	assert.Equal(t, context.Canceled, err)
+	assert.True(t, time.Since(started) < time.Second, "Should stop waiting once the context is cancelled")
+	assert.Equal(t, 1, attempts, "Should not poll again after the context is cancelled")
+}
+
+func TestGetEmbeddedSignURLRetriesNotReady(t *testing.T) {
+	attempts := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			return stubResponse(409, `{"error":{"error_msg":"This signature request is still being processed","error_name":"conflict"}}`), nil
+		}
+		return stubResponse(200, `{"embedded":{"sign_url":"https://app.hellosign.com/editor/embeddedSign?signature_id=deaf86bfb33764d9a215a07cc060122d&token=TOKEN","expires_at":1505259198}}`), nil
+	})
+	waits := []int{}
+	client.SignURLRetryPolicy = &RetryPolicy{
+		MaxRetries: 3,
+		Backoff: func(attempt int) time.Duration {
+			waits = append(waits, attempt)
+			return time.Millisecond
+		},
+	}
+
+	res, err := client.GetEmbeddedSignURL("deaf86bfb33764d9a215a07cc060122d")
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, 1505259198, res.GetExpiresAt())
+	assert.Equal(t, 2, attempts, "Should retry once the signature is ready")
+	assert.Equal(t, []int{0}, waits)
+}
+
+func TestGetEmbeddedSignURLRetryLimits(t *testing.T) {
+	attempts := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		return stubResponse(409, `{"error":{"error_msg":"This signature request is still being processed","error_name":"conflict"}}`), nil
+	})
+
+	_, err := client.GetEmbeddedSignURL("deaf86bfb33764d9a215a07cc060122d")
+	assert.EqualError(t, err, "conflict: This signature request is still being processed")
+	assert.Equal(t, 1, attempts, "Should not retry without a SignURLRetryPolicy")
+
+	attempts = 0
+	client.SignURLRetryPolicy = &RetryPolicy{MaxRetries: 2, Backoff: func(int) time.Duration { return time.Millisecond }}
+	_, err = client.GetEmbeddedSignURL("deaf86bfb33764d9a215a07cc060122d")
+	assert.EqualError(t, err, "conflict: This signature request is still being processed")
+	assert.Equal(t, 3, attempts, "Should give up after MaxRetries")
+
+	attempts = 0
+	client.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		return stubResponse(403, `{"error":{"error_msg":"Unauthorized api key","error_name":"forbidden"}}`), nil
+	})}
+	_, err = client.GetEmbeddedSignURL("deaf86bfb33764d9a215a07cc060122d")
+	assert.EqualError(t, err, "forbidden: Unauthorized api key")
+	assert.Equal(t, 1, attempts, "Should not retry other errors")
+}
+
+func TestGetEmbeddedSignURLsForRequest(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/v3/signature_request/6d7ad140141a7fe6874fec55931c363e0301c353":
+			return stubResponse(200, `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353","signatures":[`+
+				`{"signature_id":"deaf86bfb33764d9a215a07cc060122d","signer_email_address":"jane@example.com"},`+
+				`{"signature_id":"5bac8d9534194cc4dba0ed2f87ded7f5","signer_email_address":"john@example.com"}]}}`), nil
+		case "/v3/embedded/sign_url/deaf86bfb33764d9a215a07cc060122d":
+			return stubResponse(200, `{"embedded":{"sign_url":"https://app.hellosign.com/editor/embeddedSign?signature_id=deaf86bfb33764d9a215a07cc060122d&token=jane","expires_at":1505248909}}`), nil
+		case "/v3/embedded/sign_url/5bac8d9534194cc4dba0ed2f87ded7f5":
+			return stubResponse(200, `{"embedded":{"sign_url":"https://app.hellosign.com/editor/embeddedSign?signature_id=5bac8d9534194cc4dba0ed2f87ded7f5&token=john","expires_at":1505248909}}`), nil
+		}
+		return stubResponse(404, `{"error":{"error_msg":"Not found","error_name":"not_found"}}`), nil
+	})
+
+	signURLs, err := client.GetEmbeddedSignURLsForRequest("6d7ad140141a7fe6874fec55931c363e0301c353")
+	require.Nil(t, err, "Should not return error")
+
+	require.Len(t, signURLs, 2)
+	assert.Contains(t, signURLs["jane@example.com"].GetSignUrl(), "token=jane")
+	assert.Contains(t, signURLs["john@example.com"].GetSignUrl(), "token=john")
+}
+
+func TestGetEmbeddedSignURLsForRequestPartialFailure(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/v3/signature_request/6d7ad140141a7fe6874fec55931c363e0301c353":
+			return stubResponse(200, `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353","signatures":[`+
+				`{"signature_id":"deaf86bfb33764d9a215a07cc060122d","signer_email_address":"jane@example.com"},`+
+				`{"signature_id":"5bac8d9534194cc4dba0ed2f87ded7f5","signer_email_address":"john@example.com"}]}}`), nil
+		case "/v3/embedded/sign_url/deaf86bfb33764d9a215a07cc060122d":
+			return stubResponse(200, `{"embedded":{"sign_url":"https://app.hellosign.com/editor/embeddedSign?signature_id=deaf86bfb33764d9a215a07cc060122d&token=jane","expires_at":1505248909}}`), nil
+		}
+		return stubResponse(409, `{"error":{"error_msg":"This request has already been signed","error_name":"conflict"}}`), nil
+	})
+
+	signURLs, err := client.GetEmbeddedSignURLsForRequest("6d7ad140141a7fe6874fec55931c363e0301c353")
+	require.NotNil(t, err, "Should return error")
+
+	require.Len(t, signURLs, 1, "Should still return the sign URLs that were retrieved")
+	assert.Contains(t, signURLs["jane@example.com"].GetSignUrl(), "token=jane")
+
+	signURLsErr, ok := err.(*SignURLsError)
+	require.True(t, ok, "Should return a SignURLsError")
+	require.Len(t, signURLsErr.Errors, 1)
+	apiErr, ok := signURLsErr.Errors["john@example.com"].(*model.APIError)
+	require.True(t, ok, "Should keep the APIError of the signer")
+	assert.Equal(t, 409, apiErr.StatusCode)
+	assert.Contains(t, err.Error(), "john@example.com")
+}
+
+func TestSignURLResponseExpiry(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_embedded_sign_url")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetEmbeddedSignURL("deaf86bfb33764d9a215a07cc060122d")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, 1505259198, res.GetExpiresAt())
+	assert.Equal(t, time.Date(2017, time.September, 12, 23, 33, 18, 0, time.UTC), res.GetExpiresAtTime().UTC())
+	assert.True(t, res.IsExpired(), "Should be expired for a past timestamp")
+
+	fresh := &model.SignURLResponse{ExpiresAt: int(time.Now().Add(time.Hour).Unix())}
+	assert.False(t, fresh.IsExpired(), "Should not be expired for a future timestamp")
+
+	unknown := &model.SignURLResponse{}
+	assert.True(t, unknown.GetExpiresAtTime().IsZero(), "Should return the zero time without an expiry")
+	assert.False(t, unknown.IsExpired(), "Should not be expired without an expiry")
+}
+
+func TestSaveFile(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_pdf")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	fileInfo, err := client.SaveFile("6d7ad140141a7fe6874fec55931c363e0301c353", "pdf", "/tmp/download.pdf")
+
+	assert.NotNil(t, fileInfo, "Should return response")
+	assert.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, int64(98781), fileInfo.Size())
+	assert.Equal(t, "download.pdf", fileInfo.Name())
 }
-func TestCreateEmbeddedSignatureRequestWarnings(t *testing.T) {
-	// Start our recorder
-	vcr := fixture("fixtures/docsignature/embedded_signature_request_warnings")
 
-	client := createVcrClient(vcr)
+func TestGetPDF(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_pdf")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
 
-	embReq := createEmbeddedSignatureRequest()
+	client := createVcrClient(vcr)
 
-	res, err := client.CreateEmbeddedSignatureRequest(embReq)
+	data, err := client.GetPDF("6d7ad140141a7fe6874fec55931c363e0301c353")
 
-	assert.Nil(t, res, "Should not return response")
-	assert.NotNil(t, err, "Should return error")
+	assert.NotNil(t, data, "Should return response")
+	assert.Nil(t, err, "Should not return error")
 
-	assert.Equal(t, err.Error(), "parameter_missing: field is missing, empty_value: oops")
+	assert.Equal(t, 98781, len(data))
 }
 
-func TestCreateEmbeddedSignatureRequestFileURL(t *testing.T) {
-	// Start our recorder
-	vcr := fixture("fixtures/docsignature/embedded_signature_request_file_url")
+func TestGetCombinedPDF(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_pdf")
 	defer vcr.Stop() // Make sure recorder is stopped once done with it
 
 	client := createVcrClient(vcr)
 
-	request := model.EmbeddedSignatureRequest{
-		TestMode: true,
-		ClientID: os.Getenv("HELLOSIGN_CLIENT_ID"),
-		FileURL:  []string{"http://www.pdf995.com/samples/pdf.pdf"},
-		Title:    "My First Document",
-		Subject:  "Contract",
-		Signers: []model.Signer{
-			{
-				Email: "jane@example.com",
-				Name:  "Jane Doe",
-			},
-		},
-	}
+	data, err := client.GetCombinedPDF("6d7ad140141a7fe6874fec55931c363e0301c353")
+	require.Nil(t, err, "Should not return error")
 
-	res, err := client.CreateEmbeddedSignatureRequest(request)
-	assert.NotNil(t, res, "Should return response")
-	assert.Nil(t, err, "Should not return error")
+	assert.NotEmpty(t, data, "Should return response")
+	assert.True(t, bytes.HasPrefix(data, []byte("%PDF-")), "Should return the pdf bytes")
+}
 
-	assert.Equal(t, "c9af885443fad587aa2a4698086c08c64233df64", res.SignatureRequestID)
-	assert.Equal(t, "My First Document", res.Title)
-	assert.Equal(t, true, res.TestMode)
-	assert.Equal(t, false, res.IsComplete)
-	assert.Equal(t, false, res.IsDeclined)
+func TestGetCombinedPDFNotPDF(t *testing.T) {
+	var form *multipart.Form
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		form = readRequestForm(t, r)
+		res := stubResponse(200, "PK\x03\x04")
+		res.Header.Set("Content-Type", "application/zip")
+		return res, nil
+	})
+
+	data, err := client.GetCombinedPDF("6d7ad140141a7fe6874fec55931c363e0301c353")
+	assert.Nil(t, data, "Should not return response")
+	assert.EqualError(t, err, "files of signature request 6d7ad140141a7fe6874fec55931c363e0301c353 are not a pdf")
+	assert.Equal(t, []string{"pdf"}, form.Value["file_type"], "Should request the merged pdf")
 }
 
-func TestGetSignatureRequest(t *testing.T) {
-	vcr := fixture("fixtures/docsignature/get_signature_request")
+func TestDownloadFiles(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_pdf")
 	defer vcr.Stop() // Make sure recorder is stopped once done with it
 
 	client := createVcrClient(vcr)
+	w := httptest.NewRecorder()
 
-	res, err := client.GetSignatureRequest("6d7ad140141a7fe6874fec55931c363e0301c353")
+	written, err := client.DownloadFiles("6d7ad140141a7fe6874fec55931c363e0301c353", "pdf", w)
 
-	assert.NotNil(t, res, "Should return response")
 	assert.Nil(t, err, "Should not return error")
-
-	assert.Equal(t, "6d7ad140141a7fe6874fec55931c363e0301c353", res.SignatureRequestID)
-	assert.Equal(t, "awesome", res.Subject)
-	assert.Equal(t, true, res.TestMode)
-	assert.Equal(t, false, res.IsComplete)
-	assert.Equal(t, false, res.IsDeclined)
+	assert.Equal(t, int64(98781), written)
+	assert.Equal(t, 98781, w.Body.Len())
+	assert.True(t, bytes.HasPrefix(w.Body.Bytes(), []byte("%PDF-")), "Should stream the pdf bytes")
 }
 
-func TestGetSignatureRequests(t *testing.T) {
-	vcr := fixture("fixtures/docsignature/list_signature_requests")
+func TestGetFilesURL(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_files_url")
 	defer vcr.Stop() // Make sure recorder is stopped once done with it
 
 	client := createVcrClient(vcr)
 
-	res, err := client.ListSignatureRequests()
+	res, err := client.GetFilesURL("6d7ad140141a7fe6874fec55931c363e0301c353", "zip")
 
 	assert.NotNil(t, res, "Should return response")
 	assert.Nil(t, err, "Should not return error")
 
-	assert.Equal(t, 1, res.ListInfo.NumPages)
-	assert.Equal(t, 1, res.ListInfo.Page)
-	assert.Equal(t, 19, res.ListInfo.NumResults)
-	assert.Equal(t, 20, res.ListInfo.PageSize)
+	assert.Equal(t, "https://s3.amazonaws.com/hellosign_files/6d7ad140141a7fe6874fec55931c363e0301c353.zip?AWSAccessKeyId=AKIAJ&Expires=1505253305&Signature=x0f4k3s1gn", res.GetFileURL())
+	assert.Equal(t, 1505253305, res.GetExpiresAt())
+}
 
-	assert.Equal(t, 19, len(res.SignatureRequests))
+func TestGetSignatureRequestAttachments(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_signature_request_attachments")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.GetSignatureRequest("4d6c9f3eaf2b5c8d7e1f3a5b9c2d4e6f8a0b1c3d")
+	require.Nil(t, err, "Should not return error")
+	require.Len(t, res.GetAttachments(), 1)
+
+	attachment := res.GetAttachments()[0]
+	assert.Equal(t, "8c3a0ff1d3b24ab5a7e3c9d2b1f0e4a6", attachment.GetID())
+	assert.Equal(t, "Driver's License", attachment.GetName())
+	assert.True(t, attachment.GetRequired())
+	assert.Equal(t, 1505246690, attachment.GetUploadedAt())
 }
 
-func TestGetEmbeddedSignURL(t *testing.T) {
-	vcr := fixture("fixtures/docsignature/get_embedded_sign_url")
+func TestGetAttachmentURL(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_attachment_url")
 	defer vcr.Stop() // Make sure recorder is stopped once done with it
 
 	client := createVcrClient(vcr)
 
-	res, err := client.GetEmbeddedSignURL("deaf86bfb33764d9a215a07cc060122d")
+	res, err := client.GetAttachmentURL("8c3a0ff1d3b24ab5a7e3c9d2b1f0e4a6")
 
 	assert.NotNil(t, res, "Should return response")
 	assert.Nil(t, err, "Should not return error")
 
-	assert.Contains(t, res.SignURL, "embeddedSign?signature_id=deaf86bfb33764d9a215a07cc060122d&token=")
-	assert.Equal(t, 1505259198, res.ExpiresAt)
+	assert.Equal(t, "https://s3.amazonaws.com/hellosign_attachments/8c3a0ff1d3b24ab5a7e3c9d2b1f0e4a6.jpg?AWSAccessKeyId=AKIAJ&Expires=1505253305&Signature=x0f4k3s1gn", res.GetFileURL())
+	assert.Equal(t, 1505253305, res.GetExpiresAt())
 }
 
-func TestSaveFile(t *testing.T) {
-	vcr := fixture("fixtures/docsignature/get_pdf")
+func TestGetFilesDataURI(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/get_files_data_uri")
 	defer vcr.Stop() // Make sure recorder is stopped once done with it
 
 	client := createVcrClient(vcr)
 
-	fileInfo, err := client.SaveFile("6d7ad140141a7fe6874fec55931c363e0301c353", "pdf", "/tmp/download.pdf")
+	res, err := client.GetFilesDataURI("6d7ad140141a7fe6874fec55931c363e0301c353")
 
-	assert.NotNil(t, fileInfo, "Should return response")
+	assert.NotNil(t, res, "Should return response")
 	assert.Nil(t, err, "Should not return error")
 
-	assert.Equal(t, int64(98781), fileInfo.Size())
-	assert.Equal(t, "download.pdf", fileInfo.Name())
+	assert.True(t, strings.HasPrefix(res.GetDataURI(), "data:application/pdf;base64,"), "Should return a pdf data URI")
 }
 
-func TestGetPDF(t *testing.T) {
-	vcr := fixture("fixtures/docsignature/get_pdf")
-	defer vcr.Stop() // Make sure recorder is stopped once done with it
+func TestGetFilesModes(t *testing.T) {
+	forms := []url.Values{}
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		form := readRequestForm(t, r)
+		forms = append(forms, url.Values(form.Value))
+		switch {
+		case form.Value["get_data_uri"] != nil:
+			return stubResponse(200, `{"data_uri":"data:application/pdf;base64,JVBERi0xLjQ="}`), nil
+		case form.Value["get_url"][0] == "true":
+			return stubResponse(200, `{"file_url":"https://s3.amazonaws.com/hellosign_files/files.zip","expires_at":1505253305}`), nil
+		default:
+			res := stubResponse(200, "%PDF-1.4")
+			res.Header.Set("Content-Type", "application/pdf")
+			return res, nil
+		}
+	})
 
-	client := createVcrClient(vcr)
+	data, err := client.GetFiles("6d7ad140141a7fe6874fec55931c363e0301c353", "pdf")
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []byte("%PDF-1.4"), data, "Should return the file bytes")
 
-	data, err := client.GetPDF("6d7ad140141a7fe6874fec55931c363e0301c353")
+	fileURL, err := client.GetFilesURL("6d7ad140141a7fe6874fec55931c363e0301c353", "zip")
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "https://s3.amazonaws.com/hellosign_files/files.zip", fileURL.GetFileURL())
 
-	assert.NotNil(t, data, "Should return response")
-	assert.Nil(t, err, "Should not return error")
+	dataURI, err := client.GetFilesDataURI("6d7ad140141a7fe6874fec55931c363e0301c353")
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "data:application/pdf;base64,JVBERi0xLjQ=", dataURI.GetDataURI())
 
-	assert.Equal(t, 98781, len(data))
+	templateURL, err := client.GetTemplateFilesURL("f57db65d3f933b5316d398057a36176831451a35", "pdf")
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, 1505253305, templateURL.GetExpiresAt())
+
+	assert.Equal(t, []url.Values{
+		{"file_type": {"pdf"}, "get_url": {"false"}},
+		{"file_type": {"zip"}, "get_url": {"true"}},
+		{"file_type": {"pdf"}, "get_data_uri": {"true"}},
+		{"file_type": {"pdf"}, "get_url": {"true"}},
+	}, forms, "Should only differ by the form field of each mode")
 }
 
 func TestCancelSignatureRequests(t *testing.T) {
@@ -210,7 +1868,208 @@ func TestCancelSignatureRequests(t *testing.T) {
 	assert.NotNil(t, res, "Should return response")
 	assert.Nil(t, err, "Should not return error")
 
-	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, 200, res.GetStatusCode())
+}
+
+func TestCancelSignatureRequestClosesBody(t *testing.T) {
+	body := &closeRecorder{Reader: strings.NewReader(`{"warnings":[{"warning_msg":"Request was already cancelled","warning_name":"already_cancelled"}]}`)}
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/v3/signature_request/cancel/5c002b65dfefab79795a521bef312c45914cc48d", r.URL.Path)
+		return &http.Response{StatusCode: 200, Header: http.Header{}, Body: body}, nil
+	})
+
+	res, err := client.CancelSignatureRequest("5c002b65dfefab79795a521bef312c45914cc48d")
+	require.Nil(t, err, "Should not return error")
+
+	assert.True(t, body.closed, "Should close the body")
+	assert.Equal(t, 200, res.GetStatusCode())
+	require.Len(t, res.GetWarnings(), 1)
+	assert.Equal(t, "already_cancelled", res.GetWarnings()[0].GetName())
+}
+
+func TestDeleteSignatureRequest(t *testing.T) {
+	body := &closeRecorder{Reader: strings.NewReader("")}
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/v3/signature_request/remove/5c002b65dfefab79795a521bef312c45914cc48d", r.URL.Path)
+		return &http.Response{StatusCode: 200, Header: http.Header{}, Body: body}, nil
+	})
+
+	res, err := client.DeleteSignatureRequest("5c002b65dfefab79795a521bef312c45914cc48d")
+	require.Nil(t, err, "Should not return error")
+
+	assert.True(t, body.closed, "Should close the body")
+	assert.Equal(t, 200, res.GetStatusCode())
+	assert.Empty(t, res.GetWarnings())
+}
+
+func TestDeleteSignatureRequestError(t *testing.T) {
+	body := &closeRecorder{Reader: strings.NewReader(`{"error":{"error_msg":"Not found","error_name":"not_found"}}`)}
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 404, Header: http.Header{}, Body: body}, nil
+	})
+
+	res, err := client.DeleteSignatureRequest("5c002b65dfefab79795a521bef312c45914cc48d")
+	assert.Nil(t, res, "Should not return response")
+
+	apiErr, ok := err.(*model.APIError)
+	require.True(t, ok, "Should return an APIError")
+	assert.Equal(t, 404, apiErr.StatusCode)
+	assert.True(t, body.closed, "Should close the body")
+}
+
+// closeRecorder is a response body that records whether it was closed
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestTryCancelSignatureRequest(t *testing.T) {
+	var paths []string
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		if r.Method == "GET" {
+			return stubResponse(200, `{"signature_request":{"signature_request_id":"5c002b65dfefab79795a521bef312c45914cc48d","is_complete":false}}`), nil
+		}
+		return stubResponse(200, ""), nil
+	})
+
+	cancelled, err := client.TryCancelSignatureRequest("5c002b65dfefab79795a521bef312c45914cc48d")
+	require.Nil(t, err, "Should not return error")
+
+	assert.True(t, cancelled, "Should cancel an incomplete request")
+	assert.Equal(t, []string{
+		"GET /v3/signature_request/5c002b65dfefab79795a521bef312c45914cc48d",
+		"POST /v3/signature_request/cancel/5c002b65dfefab79795a521bef312c45914cc48d",
+	}, paths)
+}
+
+func TestTryCancelSignatureRequestComplete(t *testing.T) {
+	posts := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		if r.Method == "POST" {
+			posts++
+			return stubResponse(409, `{"error":{"error_msg":"This request has already been completed","error_name":"conflict"}}`), nil
+		}
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"5c002b65dfefab79795a521bef312c45914cc48d","is_complete":true}}`), nil
+	})
+
+	cancelled, err := client.TryCancelSignatureRequest("5c002b65dfefab79795a521bef312c45914cc48d")
+	assert.Nil(t, err, "Should not return error")
+
+	assert.False(t, cancelled, "Should not cancel a complete request")
+	assert.Equal(t, 0, posts, "Should not attempt the cancel")
+}
+
+func TestTryCancelSignatureRequestFinishedMeanwhile(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		if r.Method == "POST" {
+			return stubResponse(409, `{"error":{"error_msg":"This request has already been canceled","error_name":"conflict"}}`), nil
+		}
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"5c002b65dfefab79795a521bef312c45914cc48d","is_complete":false}}`), nil
+	})
+
+	cancelled, err := client.TryCancelSignatureRequest("5c002b65dfefab79795a521bef312c45914cc48d")
+	assert.Nil(t, err, "Should not return error when the request was finished before the cancel")
+	assert.False(t, cancelled, "Should not report the request as cancelled")
+}
+
+func TestTryCancelSignatureRequestError(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		if r.Method == "POST" {
+			return stubResponse(403, `{"error":{"error_msg":"You do not have access to this signature request","error_name":"forbidden"}}`), nil
+		}
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"5c002b65dfefab79795a521bef312c45914cc48d","is_complete":false}}`), nil
+	})
+
+	cancelled, err := client.TryCancelSignatureRequest("5c002b65dfefab79795a521bef312c45914cc48d")
+	assert.False(t, cancelled)
+
+	apiErr, ok := err.(*model.APIError)
+	require.True(t, ok, "Should return an APIError")
+	assert.Equal(t, 403, apiErr.StatusCode)
+	assert.Equal(t, "forbidden", apiErr.ErrorName)
+}
+
+func TestCancelSignatureRequestsInParallel(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/v3/signature_request/cancel/completed":
+			return stubResponse(409, `{"error":{"error_msg":"This request has already been completed","error_name":"conflict"}}`), nil
+		case "/v3/signature_request/cancel/missing":
+			return stubResponse(404, `{"error":{"error_msg":"Not found","error_name":"not_found"}}`), nil
+		}
+		return stubResponse(200, ""), nil
+	})
+
+	ids := []string{"first", "completed", "second", "missing", "third"}
+	results, err := client.CancelSignatureRequests(context.Background(), ids, 2)
+	require.Nil(t, err, "Should not return error")
+
+	require.Len(t, results, 5)
+	assert.Nil(t, results["first"])
+	assert.Nil(t, results["second"])
+	assert.Nil(t, results["third"])
+
+	apiErr, ok := results["completed"].(*model.APIError)
+	require.True(t, ok, "Should return an APIError")
+	assert.Equal(t, 409, apiErr.StatusCode)
+	apiErr, ok = results["missing"].(*model.APIError)
+	require.True(t, ok, "Should return an APIError")
+	assert.Equal(t, 404, apiErr.StatusCode)
+
+	assert.True(t, maxInFlight <= 2, "Should not exceed the concurrency")
+}
+
+func TestCancelSignatureRequestsContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		cancel()
+		return stubResponse(200, ""), nil
+	})
+
+	results, err := client.CancelSignatureRequests(ctx, []string{"first", "second", "third"}, 1)
+	assert.Equal(t, context.Canceled, err)
+
+	require.Len(t, results, 3)
+	assert.Nil(t, results["first"], "Should keep the result of the cancel in flight")
+	assert.Equal(t, context.Canceled, results["second"])
+	assert.Equal(t, context.Canceled, results["third"])
+}
+
+func TestCancelSignatureRequestsAbortsInFlight(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		cancel()
+		<-r.Context().Done()
+		return nil, r.Context().Err()
+	})
+
+	results, err := client.CancelSignatureRequests(ctx, []string{"first", "second"}, 1)
+	assert.Equal(t, context.Canceled, err)
+
+	require.Len(t, results, 2)
+	assert.True(t, errors.Is(results["first"], context.Canceled), "Should abort the cancel in flight")
+	assert.Equal(t, context.Canceled, results["second"])
 }
 
 func TestUpdateSignatureRequestSuccess(t *testing.T) {
@@ -250,6 +2109,40 @@ func TestUpdateSignatureRequestFails(t *testing.T) {
 	assert.Equal(t, "deleted: This resource has been deleted", err.Error())
 }
 
+func TestRemindSignatureRequestSuccess(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/remind_signature_request")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.RemindSignatureRequest("9040be434b1301e31019b3dad895ed580f8ca890", "freddy@hellosign.com")
+
+	require.Nil(t, err, "Should not return error")
+	assert.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "9040be434b1301e31019b3dad895ed580f8ca890", res.GetSignatureRequestID())
+	assert.Equal(t, "freddy@hellosign.com", res.GetSignatures()[0].GetSignerEmailAddress())
+	assert.Equal(t, 1505250023, res.GetSignatures()[0].GetLastRemindedAt())
+}
+
+func TestRemindSignatureRequestConflict(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/remind_signature_request_conflict")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+
+	res, err := client.RemindSignatureRequest("6d7ad140141a7fe6874fec55931c363e0301c353", "freddy@hellosign.com")
+
+	assert.Nil(t, res, "Should not return response")
+	require.NotNil(t, err, "Should return error")
+
+	var apiErr *model.APIError
+	require.True(t, errors.As(err, &apiErr), "Should return an APIError")
+	assert.Equal(t, 409, apiErr.GetStatusCode())
+	assert.Equal(t, "conflict", apiErr.GetErrorName())
+	assert.Equal(t, "This request has already been completed", apiErr.GetErrorMsg())
+}
+
 func TestCreateEmbeddedSignatureWithTemplateRequestSuccess(t *testing.T) {
 	// Start our recorder
 	vcr := fixture("fixtures/docsignature/embedded_signature_with_template_request")
@@ -278,6 +2171,75 @@ func TestCreateEmbeddedSignatureWithTemplateRequestSuccess(t *testing.T) {
 	assert.Contains(t, res.GetTemplateIDs(), templateID)
 }
 
+func TestCreateSignatureRequestWithTemplateSuccess(t *testing.T) {
+	vcr := fixture("fixtures/docsignature/send_signature_request_with_template")
+	defer vcr.Stop() // Make sure recorder is stopped once done with it
+
+	client := createVcrClient(vcr)
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Applicant",
+		},
+	}
+
+	req := createSignatureRequestSendWithTemplateRequest()
+	res, err := client.CreateSignatureRequestWithTemplate(req, signerRoles)
+
+	assert.NotNil(t, res, "Should return response")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, "3b1d5c7a9e2f4b6d8a0c1e3f5a7b9d2c4e6f8a0b", res.GetSignatureRequestID())
+	assert.Equal(t, req.GetTemplateIDs(), res.GetTemplateIDs())
+	assert.Equal(t, "https://example.com/signed", res.GetSigningRedirectURL())
+}
+
+func TestSignatureRequestSendWithTemplateRequestMarshalling(t *testing.T) {
+	client := Client{}
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Applicant",
+		},
+	}
+
+	params, writer, err := client.marshalMultipartSignatureWithTemplateRequest(createSignatureRequestSendWithTemplateRequest(), signerRoles)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"fc47b729f5611a75894680947c573f8a09fcb52c"}, form.Value["template_ids[0]"])
+	assert.Equal(t, []string{"76a888f4ca1dc1f726cbfd3381d7b9a19066c047"}, form.Value["template_ids[1]"])
+	assert.Equal(t, []string{"accounting@example.com"}, form.Value["ccs[Accounting][email_address]"])
+	assert.Equal(t, []string{`{"Salary":"$1"}`}, form.Value[CustomFieldsKey])
+	assert.Equal(t, []string{"https://example.com/signed"}, form.Value["signing_redirect_url"])
+	assert.Equal(t, []string{"freddy@hellosign.com"}, form.Value["signers[Applicant][email_address]"])
+}
+
+func TestTemplateCustomFieldsWithEditorMarshalling(t *testing.T) {
+	client := Client{}
+	signerRoles := []model.SignerRole{
+		{
+			Name: "Applicant",
+		},
+	}
+
+	editor := "Applicant"
+	req := createSignatureRequestSendWithTemplateRequest()
+	req.CustomFields = []model.CustomField{
+		{Name: "Salary", Value: "$1"},
+		{Name: "Start Date", Value: "2020-01-06", Editor: &editor, Required: true},
+	}
+
+	params, writer, err := client.marshalMultipartSignatureWithTemplateRequest(req, signerRoles)
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t,
+		[]string{`[{"name":"Salary","value":"$1","required":false},{"name":"Start Date","value":"2020-01-06","editor":"Applicant","required":true}]`},
+		form.Value[CustomFieldsKey],
+		"Should send the array form to lock a field to its editor",
+	)
+}
+
 // Private Functions
 
 func fixture(path string) *recorder.Recorder {
@@ -298,13 +2260,102 @@ func fixture(path string) *recorder.Recorder {
 func createVcrClient(transport *recorder.Recorder) Client {
 	httpClient := &http.Client{Transport: transport}
 
+	apiKey := os.Getenv("HELLOSIGN_API_KEY")
+	if apiKey == "" {
+		apiKey = "api_key" // Cassettes replay without credentials, but the client requires one.
+	}
+
 	client := Client{
-		APIKey:     os.Getenv("HELLOSIGN_API_KEY"),
+		APIKey:     apiKey,
 		HTTPClient: httpClient,
 	}
 	return client
 }
 
+// roundTripFunc lets tests inspect outgoing requests and return canned responses
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func createStubClient(f roundTripFunc) Client {
+	return Client{
+		APIKey:     "api_key",
+		HTTPClient: &http.Client{Transport: f},
+	}
+}
+
+func stubResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func readRequestForm(t *testing.T, r *http.Request) *multipart.Form {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	require.Nil(t, err, "Should send a multipart content type")
+	form, err := multipart.NewReader(r.Body, params["boundary"]).ReadForm(10 << 20)
+	require.Nil(t, err, "Should parse multipart body")
+	return form
+}
+
+func readMultipartForm(t *testing.T, params *bytes.Buffer, writer *multipart.Writer) *multipart.Form {
+	form, err := multipart.NewReader(params, writer.Boundary()).ReadForm(10 << 20)
+	require.Nil(t, err, "Should parse multipart body")
+	return form
+}
+
+func createSignatureRequestSendRequest() model.SignatureRequestSendRequest {
+	return model.SignatureRequestSendRequest{
+		TestMode: true,
+		FileURL:  []string{"http://www.pdf995.com/samples/pdf.pdf"},
+		Title:    "My First Document",
+		Subject:  "Contract",
+		Message:  "Please sign this contract",
+		Signers: []model.Signer{
+			{
+				Email: "jane@example.com",
+				Name:  "Jane Doe",
+			},
+		},
+	}
+}
+
+func createSignatureRequestSendWithTemplateRequest() model.SignatureRequestSendWithTemplateRequest {
+	return model.SignatureRequestSendWithTemplateRequest{
+		TestMode: true,
+		TemplateIDs: []string{
+			"fc47b729f5611a75894680947c573f8a09fcb52c",
+			"76a888f4ca1dc1f726cbfd3381d7b9a19066c047",
+		},
+		Title:              "Offer Pack",
+		Subject:            "awesome",
+		Message:            "cool message bro",
+		SigningRedirectURL: "https://example.com/signed",
+		Signers: []model.Signer{
+			{
+				Email: "freddy@hellosign.com",
+				Name:  "Freddy Rangel",
+			},
+		},
+		CCs: []model.CCRole{
+			{
+				Name:  "Accounting",
+				Email: "accounting@example.com",
+			},
+		},
+		CustomFields: []model.CustomField{
+			{
+				Name:  "Salary",
+				Value: "$1",
+			},
+		},
+	}
+}
+
 func createEmbeddedSignatureWithTemplateRequest(templateID string) model.EmbeddedSignatureWithTemplateRequest {
 
 	return model.EmbeddedSignatureWithTemplateRequest{
@@ -364,7 +2415,7 @@ func createEmbeddedSignatureRequest() model.EmbeddedSignatureRequest {
 			"no@dogs.com",
 		},
 		UseTextTags:  false,
-		HideTextTags: true,
+		HideTextTags: false,
 		Metadata: map[string]string{
 			"no":   "cats",
 			"more": "dogs",
@@ -0,0 +1,136 @@
+package hellosign
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+)
+
+const (
+	EventJSONKey string = "json"
+
+	// EventReceivedResponse is the body HelloSign expects back, otherwise it keeps retrying the callback
+	EventReceivedResponse string = "Hello API Event Received"
+
+	// maxEventCallbackBytes caps the callback body WebhookHandler reads, callbacks are a few KB of JSON
+	maxEventCallbackBytes int64 = 10 << 20
+)
+
+// ParseEvent - Decodes an event callback. HelloSign posts callbacks as multipart/form-data
+// with the event JSON in a field named json, so r must be the raw multipart body.
+func ParseEvent(r io.Reader) (*model.Event, error) {
+	body := bufio.NewReader(r)
+
+	// The body opens with the boundary delimiter, which is all the multipart reader needs
+	line, err := body.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = bytes.TrimRight(line, "\r\n")
+	if !bytes.HasPrefix(line, []byte("--")) {
+		return nil, errors.New("event callback is not a multipart body")
+	}
+	boundary := string(line[2:])
+
+	return readEvent(multipart.NewReader(io.MultiReader(bytes.NewReader(append(line, '\r', '\n')), body), boundary))
+}
+
+// readEvent – Decodes the event from the json field of a multipart event callback
+func readEvent(mr *multipart.Reader) (*model.Event, error) {
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return nil, errors.New("event callback is missing the json field")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if part.FormName() != EventJSONKey {
+			continue
+		}
+
+		callback := &model.EventCallback{}
+		if err := json.NewDecoder(part).Decode(callback); err != nil {
+			return nil, err
+		}
+		event := callback.GetEvent()
+		if event == nil {
+			return nil, errors.New("event callback is missing the event object")
+		}
+		event.SignatureRequest = callback.GetSignatureRequest()
+		event.Template = callback.GetTemplate()
+		return event, nil
+	}
+}
+
+// VerifyEventHash - Reports whether the event_hash was produced by HelloSign using this client's APIKey.
+// Without an APIKey nothing can be verified, as anyone can compute a hash with an empty key.
+func (m *Client) VerifyEventHash(event model.Event) bool {
+	if m.APIKey == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(m.APIKey))
+	mac.Write([]byte(event.GetEventTime() + event.GetEventType()))
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(event.GetEventHash()))
+}
+
+// WebhookHandler - Returns an http.Handler for the callback URL. It parses the callback, rejects it with
+// a 400 unless the event hash matches this client's APIKey, then calls callback and acknowledges the event.
+// Every callback is rejected when the client has no APIKey, as none can be verified. The multipart boundary
+// is taken from the Content-Type header, and bodies over 10 MB are rejected with a 413.
+func (m *Client) WebhookHandler(callback func(model.Event)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.APIKey == "" {
+			http.Error(w, "no api key to verify the event hash", http.StatusBadRequest)
+			return
+		}
+
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "multipart/form-data" || params["boundary"] == "" {
+			http.Error(w, "event callback is not a multipart body", http.StatusBadRequest)
+			return
+		}
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxEventCallbackBytes))
+		if err != nil {
+			// MaxBytesReader returns the bytes up to the limit before failing
+			if int64(len(body)) >= maxEventCallbackBytes {
+				http.Error(w, "event callback is too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		event, err := readEvent(multipart.NewReader(bytes.NewReader(body), params["boundary"]))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if !m.VerifyEventHash(*event) {
+			http.Error(w, "invalid event hash", http.StatusBadRequest)
+			return
+		}
+
+		callback(*event)
+
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		io.WriteString(w, EventReceivedResponse)
+	})
+}
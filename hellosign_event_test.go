@@ -0,0 +1,233 @@
+package hellosign
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const eventAPIKey = "7ab3b2cc1b3d0ae0ba0ad8ec2a4c2e4b9a6e0f3493f7b4a2e1c0d9e8f7a6b5c4"
+
+func TestParseEvent(t *testing.T) {
+	body, _ := createEventCallbackBody(t, "fixtures/event/signature_request_sent.json")
+
+	event, err := ParseEvent(body)
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, event, "Should return event")
+
+	assert.Equal(t, "1348177752", event.GetEventTime())
+	assert.Equal(t, "signature_request_sent", event.GetEventType())
+	assert.Equal(t, "691ab63242c0ba8e5ba1fea8971391c1a7a304c45ce4af748da266040867c030", event.GetEventHash())
+}
+
+func TestParseEventWithSignatureRequest(t *testing.T) {
+	body, _ := createEventCallbackBody(t, "fixtures/event/signature_request_signed.json")
+
+	event, err := ParseEvent(body)
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, event, "Should return event")
+
+	assert.Equal(t, "signature_request_signed", event.GetEventType())
+
+	metadata := event.GetEventMetadata()
+	require.NotNil(t, metadata, "Should decode event_metadata")
+	assert.Equal(t, "78caf2a1d01cd39cea2bc1cbb340dac3", metadata.GetRelatedSignatureID())
+	assert.Equal(t, "63522885f9261e2b04eea043933ee7313eb674fd", metadata.GetReportedForAccountID())
+	assert.Equal(t, "", metadata.GetEventMessage())
+
+	sigReq := event.GetSignatureRequest()
+	require.NotNil(t, sigReq, "Should decode the signature_request")
+	assert.Equal(t, "17d163069282df5eb63857d31ff4a3bffa9e46c0", sigReq.GetSignatureRequestID())
+	assert.Equal(t, "Offer Letter", sigReq.GetTitle())
+	assert.Equal(t, "42", sigReq.GetMetadata()["employee_id"])
+	require.Len(t, sigReq.GetSignatures(), 2)
+	assert.Equal(t, "signed", sigReq.GetSignatures()[0].GetStatusCode())
+	assert.Equal(t, "awaiting_signature", sigReq.GetSignatures()[1].GetStatusCode())
+	assert.Nil(t, event.GetTemplate(), "Should not return a template for a signature request event")
+
+	client := Client{APIKey: eventAPIKey}
+	assert.True(t, client.VerifyEventHash(*event), "Should accept hash signed with the api key")
+}
+
+func TestParseEventRawJSON(t *testing.T) {
+	payload, err := ioutil.ReadFile("fixtures/event/signature_request_sent.json")
+	require.Nil(t, err)
+
+	event, err := ParseEvent(bytes.NewReader(payload))
+	assert.Nil(t, event, "Should not return event")
+	assert.NotNil(t, err, "Should reject a body that is not multipart")
+}
+
+func TestVerifyEventHash(t *testing.T) {
+	body, _ := createEventCallbackBody(t, "fixtures/event/signature_request_sent.json")
+	event, err := ParseEvent(body)
+	require.Nil(t, err, "Should not return error")
+
+	client := Client{APIKey: eventAPIKey}
+	assert.True(t, client.VerifyEventHash(*event), "Should accept hash signed with the api key")
+
+	otherClient := Client{APIKey: "not the api key"}
+	assert.False(t, otherClient.VerifyEventHash(*event), "Should reject hash signed with another key")
+
+	tampered := *event
+	tampered.EventType = "signature_request_signed"
+	assert.False(t, client.VerifyEventHash(tampered), "Should reject a tampered event")
+}
+
+func TestVerifyEventHashWithoutAPIKey(t *testing.T) {
+	event := model.Event{EventTime: "1505259198", EventType: "signature_request_sent"}
+	mac := hmac.New(sha256.New, []byte(""))
+	mac.Write([]byte(event.GetEventTime() + event.GetEventType()))
+	event.EventHash = hex.EncodeToString(mac.Sum(nil))
+
+	client := Client{AccessToken: "oauth_token"}
+	assert.False(t, client.VerifyEventHash(event), "Should reject a hash signed with an empty key")
+}
+
+func TestWebhookHandler(t *testing.T) {
+	body, writer := createEventCallbackBody(t, "fixtures/event/signature_request_sent.json")
+
+	var received []model.Event
+	client := Client{APIKey: eventAPIKey}
+	handler := client.WebhookHandler(func(event model.Event) {
+		received = append(received, event)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/hellosign/callback", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Hello API Event Received", rec.Body.String(), "Should write the body HelloSign expects")
+	require.Len(t, received, 1, "Should call the callback once")
+	assert.Equal(t, "signature_request_sent", received[0].GetEventType())
+}
+
+func TestWebhookHandlerRejectsBadHash(t *testing.T) {
+	body, writer := createEventCallbackBody(t, "fixtures/event/signature_request_sent.json")
+
+	client := Client{APIKey: "not the api key"}
+	handler := client.WebhookHandler(func(event model.Event) {
+		t.Fatal("Should not call the callback")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/hellosign/callback", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.NotContains(t, rec.Body.String(), EventReceivedResponse, "Should not acknowledge the event")
+}
+
+func TestWebhookHandlerRejectsWithoutAPIKey(t *testing.T) {
+	body, writer := createEventCallbackBody(t, "fixtures/event/signature_request_sent.json")
+
+	client := Client{AccessToken: "oauth_token"}
+	handler := client.WebhookHandler(func(event model.Event) {
+		t.Fatal("Should not call the callback")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/hellosign/callback", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.NotContains(t, rec.Body.String(), EventReceivedResponse, "Should not acknowledge the event")
+}
+
+func TestWebhookHandlerRejectsMalformedBody(t *testing.T) {
+	client := Client{APIKey: eventAPIKey}
+	handler := client.WebhookHandler(func(event model.Event) {
+		t.Fatal("Should not call the callback")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/hellosign/callback", strings.NewReader("not an event"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestWebhookHandlerUsesContentTypeBoundary(t *testing.T) {
+	body, writer := createEventCallbackBody(t, "fixtures/event/signature_request_sent.json")
+
+	var received []model.Event
+	client := Client{APIKey: eventAPIKey}
+	handler := client.WebhookHandler(func(event model.Event) {
+		received = append(received, event)
+	})
+
+	// A preamble before the first boundary is valid multipart, so the boundary can't be read from the body
+	req := httptest.NewRequest(http.MethodPost, "/hellosign/callback", io.MultiReader(strings.NewReader("preamble\r\n"), body))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, received, 1, "Should call the callback once")
+}
+
+func TestWebhookHandlerRejectsMissingContentType(t *testing.T) {
+	body, _ := createEventCallbackBody(t, "fixtures/event/signature_request_sent.json")
+
+	client := Client{APIKey: eventAPIKey}
+	handler := client.WebhookHandler(func(event model.Event) {
+		t.Fatal("Should not call the callback")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/hellosign/callback", body)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestWebhookHandlerRejectsLargeBody(t *testing.T) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	formField, err := w.CreateFormField(EventJSONKey)
+	require.Nil(t, err)
+	formField.Write(bytes.Repeat([]byte(" "), int(maxEventCallbackBytes)))
+	w.Close()
+
+	client := Client{APIKey: eventAPIKey}
+	handler := client.WebhookHandler(func(event model.Event) {
+		t.Fatal("Should not call the callback")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/hellosign/callback", &b)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+// createEventCallbackBody builds the multipart body HelloSign posts, with the payload in the json field
+func createEventCallbackBody(t *testing.T, payloadPath string) (*bytes.Buffer, *multipart.Writer) {
+	payload, err := ioutil.ReadFile(payloadPath)
+	require.Nil(t, err)
+
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	formField, err := w.CreateFormField(EventJSONKey)
+	require.Nil(t, err)
+	formField.Write(payload)
+	w.Close()
+
+	return &b, w
+}
@@ -0,0 +1,106 @@
+package hellosign
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"mime/multipart"
+	"reflect"
+)
+
+const (
+	oauthTokenURL         string = "https://app.hellosign.com/oauth/token"
+	GrantTypeKey          string = "grant_type"
+	AuthorizationCodeType string = "authorization_code"
+	RefreshTokenType      string = "refresh_token"
+)
+
+// GetOAuthToken – Exchanges the code passed to your OAuth callback for an access token.
+func (m *Client) GetOAuthToken(req model.OAuthTokenRequest) (*model.OAuthData, error) {
+	params, writer, err := m.marshalMultipartOAuthTokenRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.requestOAuthToken(params, writer)
+}
+
+// RefreshOAuthToken – Obtains a new access token once the previous one has expired.
+func (m *Client) RefreshOAuthToken(refreshToken string) (*model.OAuthData, error) {
+	var params bytes.Buffer
+	writer, err := m.newMultipartWriter(&params)
+	if err != nil {
+		return nil, err
+	}
+
+	grantType, err := writer.CreateFormField(GrantTypeKey)
+	if err != nil {
+		return nil, err
+	}
+	grantType.Write([]byte(RefreshTokenType))
+
+	token, err := writer.CreateFormField(RefreshTokenType)
+	if err != nil {
+		return nil, err
+	}
+	token.Write([]byte(refreshToken))
+	writer.Close()
+
+	return m.requestOAuthToken(&params, writer)
+}
+
+func (m *Client) marshalMultipartOAuthTokenRequest(req model.OAuthTokenRequest) (*bytes.Buffer, *multipart.Writer, error) {
+	var b bytes.Buffer
+	w, err := m.newMultipartWriter(&b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grantType, err := w.CreateFormField(GrantTypeKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	grantType.Write([]byte(AuthorizationCodeType))
+
+	structType := reflect.TypeOf(req)
+	val := reflect.ValueOf(req)
+
+	for i := 0; i < val.NumField(); i++ {
+		valueField := val.Field(i)
+		field := structType.Field(i)
+		fieldTag := field.Tag.Get(FormFieldKey)
+
+		if valueField.String() != "" {
+			formField, err := w.CreateFormField(fieldTag)
+			if err != nil {
+				return nil, nil, err
+			}
+			formField.Write([]byte(valueField.String()))
+		}
+	}
+
+	w.Close()
+	return &b, w, nil
+}
+
+// requestOAuthToken – The token endpoint lives outside the v3 API and authenticates with the app credentials in the body,
+// so it is sent without the client's credentials but otherwise like every other request
+func (m *Client) requestOAuthToken(params *bytes.Buffer, w *multipart.Writer) (*model.OAuthData, error) {
+	response, err := m.doEndpoint("POST", oauthTokenURL, params.Bytes(), w.FormDataContentType(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.checkResponse(response); err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	data := &model.OAuthData{}
+	err = json.NewDecoder(response.Body).Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
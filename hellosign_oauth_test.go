@@ -0,0 +1,98 @@
+package hellosign
+
+import (
+	"context"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mime/multipart"
+	"net/http"
+	"testing"
+)
+
+func TestClient_GetOAuthToken(t *testing.T) {
+	vcr := fixture("fixtures/oauth/get_oauth_token")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.GetOAuthToken(model.OAuthTokenRequest{
+		Code:         "1b0d28d90c86c141",
+		State:        "900e06e2",
+		ClientID:     "0dd3b823a682527788c4e40cb7b6f7e9",
+		ClientSecret: "c3a4f9c1b2d8e7f6a5b4c3d2e1f0a9b8",
+	})
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "NWNiOTMxOGFkOGVjMDhhNTAxZN2NkNjgxMjMwOWJiYTEzZTBmZGUzMjMThhMzYyMzc=", res.GetAccessToken())
+	assert.Equal(t, "Bearer", res.GetTokenType())
+	assert.Equal(t, 86400, res.GetExpiresIn())
+}
+
+func TestClient_OAuthTokenRequestMarshalling(t *testing.T) {
+	client := Client{}
+
+	params, writer, err := client.marshalMultipartOAuthTokenRequest(model.OAuthTokenRequest{
+		Code:         "1b0d28d90c86c141",
+		ClientID:     "0dd3b823a682527788c4e40cb7b6f7e9",
+		ClientSecret: "c3a4f9c1b2d8e7f6a5b4c3d2e1f0a9b8",
+	})
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{AuthorizationCodeType}, form.Value[GrantTypeKey])
+	assert.Equal(t, []string{"1b0d28d90c86c141"}, form.Value["code"])
+	assert.NotContains(t, form.Value, "state")
+}
+
+func TestClient_RefreshOAuthToken(t *testing.T) {
+	var form *multipart.Form
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, oauthTokenURL, r.URL.String())
+		assert.Empty(t, r.Header.Get("Authorization"), "Should not send api credentials to the token endpoint")
+
+		form = readRequestForm(t, r)
+
+		return stubResponse(200, `{"access_token":"new_token","token_type":"Bearer","refresh_token":"next_refresh","expires_in":86400}`), nil
+	})
+
+	res, err := client.RefreshOAuthToken("hNTI2MTFmM2VmZDQxZTZjOWRmZmFjZmVmMGMyNGFjMzI2MGI5YzgzNmE3")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, []string{RefreshTokenType}, form.Value[GrantTypeKey])
+	assert.Equal(t, []string{"hNTI2MTFmM2VmZDQxZTZjOWRmZmFjZmVmMGMyNGFjMzI2MGI5YzgzNmE3"}, form.Value[RefreshTokenType])
+	assert.Equal(t, "new_token", res.GetAccessToken())
+	assert.Equal(t, "next_refresh", res.GetRefreshToken())
+}
+
+func TestClient_RefreshOAuthTokenSharesClientSettings(t *testing.T) {
+	var request *http.Request
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		request = r
+		return stubResponse(200, `{"access_token":"new_token","token_type":"Bearer","refresh_token":"next_refresh","expires_in":86400}`), nil
+	})
+	limiter := &countingLimiter{}
+	client.RateLimiter = limiter
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := client.WithRequestOptions(RequestOptions{Context: ctx}).RefreshOAuthToken("hNTI2MTFmM2VmZDQxZTZjOWRmZmFjZmVmMGMyNGFjMzI2MGI5YzgzNmE3")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, 1, limiter.waits, "Should wait on the rate limiter")
+	assert.Equal(t, defaultUserAgent, request.Header.Get("User-Agent"))
+	assert.Equal(t, ctx, request.Context(), "Should send the request with the context")
+	assert.Empty(t, request.Header.Get("Authorization"), "Should not send api credentials to the token endpoint")
+}
+
+// countingLimiter never blocks, counting how often it is waited on
+type countingLimiter struct {
+	waits int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return nil
+}
@@ -0,0 +1,75 @@
+package hellosign
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"mime/multipart"
+)
+
+const (
+	StartDateKey  string = "start_date"
+	EndDateKey    string = "end_date"
+	ReportTypeKey string = "report_type"
+
+	reportDateFormat = "01/02/2006"
+)
+
+// CreateReport – Requests a report of account activity between the two dates. The report is emailed once it is ready.
+func (m *Client) CreateReport(req model.ReportRequest) (*model.Report, error) {
+	params, writer, err := m.marshalMultipartReportRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post("report/create", params, *writer)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	resp := &model.ReportResponse{}
+	err = json.NewDecoder(response.Body).Decode(resp)
+
+	return resp.GetReport(), err
+}
+
+func (m *Client) marshalMultipartReportRequest(req model.ReportRequest) (*bytes.Buffer, *multipart.Writer, error) {
+	if !req.EndDate.After(req.StartDate) {
+		return nil, nil, errors.New("end_date must be after start_date")
+	}
+	if len(req.ReportTypes) == 0 {
+		return nil, nil, errors.New("at least one report_type is required")
+	}
+
+	var b bytes.Buffer
+	w, err := m.newMultipartWriter(&b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startDate, err := w.CreateFormField(StartDateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	startDate.Write([]byte(req.StartDate.Format(reportDateFormat)))
+
+	endDate, err := w.CreateFormField(EndDateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	endDate.Write([]byte(req.EndDate.Format(reportDateFormat)))
+
+	for i, reportType := range req.ReportTypes {
+		formField, err := w.CreateFormField(fmt.Sprintf("%s[%v]", ReportTypeKey, i))
+		if err != nil {
+			return nil, nil, err
+		}
+		formField.Write([]byte(reportType))
+	}
+
+	w.Close()
+	return &b, w, nil
+}
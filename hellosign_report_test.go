@@ -0,0 +1,62 @@
+package hellosign
+
+import (
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClient_CreateReport(t *testing.T) {
+	vcr := fixture("fixtures/report/create_report")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.CreateReport(createReportRequest())
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Contains(t, res.GetSuccess(), "You will receive an email")
+	assert.Equal(t, "09/01/2021", res.GetStartDate())
+	assert.Equal(t, []string{"user_activity", "document_status"}, res.GetReportTypes())
+}
+
+func TestReportRequestMarshalling(t *testing.T) {
+	client := Client{}
+
+	params, writer, err := client.marshalMultipartReportRequest(createReportRequest())
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+
+	assert.Equal(t, []string{"09/01/2021"}, form.Value[StartDateKey])
+	assert.Equal(t, []string{"09/30/2021"}, form.Value[EndDateKey])
+	assert.Equal(t, []string{"user_activity"}, form.Value["report_type[0]"])
+	assert.Equal(t, []string{"document_status"}, form.Value["report_type[1]"])
+}
+
+func TestReportRequestValidation(t *testing.T) {
+	client := Client{}
+
+	req := createReportRequest()
+	req.EndDate = req.StartDate
+	_, _, err := client.marshalMultipartReportRequest(req)
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, "end_date must be after start_date", err.Error())
+
+	req = createReportRequest()
+	req.ReportTypes = nil
+	_, _, err = client.marshalMultipartReportRequest(req)
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, "at least one report_type is required", err.Error())
+}
+
+func createReportRequest() model.ReportRequest {
+	return model.ReportRequest{
+		StartDate:   time.Date(2021, time.September, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2021, time.September, 30, 0, 0, 0, 0, time.UTC),
+		ReportTypes: []string{model.ReportTypeUserActivity, model.ReportTypeDocumentStatus},
+	}
+}
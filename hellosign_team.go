@@ -0,0 +1,100 @@
+package hellosign
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"net/http"
+)
+
+const (
+	NameKey          string = "name"
+	NewOwnerEmailKey string = "new_owner_email_address"
+)
+
+// GetTeam – Returns information about your team as well as a list of its members.
+func (m *Client) GetTeam() (*model.Team, error) {
+	response, err := m.get("team")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseTeamResponse(response)
+}
+
+// CreateTeam – Creates a new team and makes you a member. You must not currently belong to a team.
+func (m *Client) CreateTeam(name string) (*model.Team, error) {
+	return m.postTeamName("team/create", name)
+}
+
+// UpdateTeam – Updates the name of your team.
+func (m *Client) UpdateTeam(name string) (*model.Team, error) {
+	return m.postTeamName("team", name)
+}
+
+// DestroyTeam – Deletes your team. Can only be invoked when you have a team with only one member (yourself).
+// A rejected destroy, eg: while the team has other members, is returned as a *model.APIError.
+func (m *Client) DestroyTeam() (*model.OperationResult, error) {
+	response, err := m.nakedPost("team/destroy")
+	if err != nil {
+		return nil, err
+	}
+	return m.parseOperationResult(response)
+}
+
+// AddMemberToTeam – Adds or invites a user to your team. Exactly one of accountID or email must be provided.
+func (m *Client) AddMemberToTeam(accountID string, email string) (*model.Team, error) {
+	return m.updateTeamMember("team/add_member", accountID, email, nil)
+}
+
+// RemoveMemberFromTeam – Removes a user from your team. Exactly one of accountID or email must be provided.
+// When newOwnerEmail is set, the removed user's documents are transferred to that team member.
+func (m *Client) RemoveMemberFromTeam(accountID string, email string, newOwnerEmail string) (*model.Team, error) {
+	return m.updateTeamMember("team/remove_member", accountID, email, map[string]string{NewOwnerEmailKey: newOwnerEmail})
+}
+
+func (m *Client) updateTeamMember(path string, accountID string, email string, extra map[string]string) (*model.Team, error) {
+	params, writer, err := m.marshalMultipartAccountIdentifier(accountID, email, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post(path, params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseTeamResponse(response)
+}
+
+func (m *Client) postTeamName(path string, name string) (*model.Team, error) {
+	var params bytes.Buffer
+	writer, err := m.newMultipartWriter(&params)
+	if err != nil {
+		return nil, err
+	}
+
+	nameField, err := writer.CreateFormField(NameKey)
+	if err != nil {
+		return nil, err
+	}
+	nameField.Write([]byte(name))
+	writer.Close()
+
+	response, err := m.post(path, &params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseTeamResponse(response)
+}
+
+// parseTeamResponse – Parses the team response and converts it into the team model
+func (m *Client) parseTeamResponse(response *http.Response) (*model.Team, error) {
+	defer response.Body.Close()
+
+	resp := &model.TeamResponse{}
+	err := json.NewDecoder(response.Body).Decode(resp)
+
+	return resp.GetTeam(), err
+}
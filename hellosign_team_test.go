@@ -0,0 +1,124 @@
+package hellosign
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestClient_GetTeam(t *testing.T) {
+	vcr := fixture("fixtures/team/get_team")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.GetTeam()
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "Deputy Engineering", res.GetName())
+	require.Len(t, res.GetAccounts(), 2)
+	assert.Equal(t, "me@hellosign.com", res.GetAccounts()[0].GetEmailAddress())
+	assert.Equal(t, "a", res.GetAccounts()[0].GetRoleCode())
+	require.Len(t, res.GetInvitedAccounts(), 1)
+	assert.Equal(t, "invitee@hellosign.com", res.GetInvitedAccounts()[0].GetEmailAddress())
+}
+
+func TestClient_CreateTeam(t *testing.T) {
+	vcr := fixture("fixtures/team/create_team")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.CreateTeam("Deputy Engineering")
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "Deputy Engineering", res.GetName())
+	assert.Len(t, res.GetAccounts(), 1)
+	assert.Len(t, res.GetInvitedAccounts(), 0)
+}
+
+func TestClient_UpdateTeam(t *testing.T) {
+	vcr := fixture("fixtures/team/update_team")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.UpdateTeam("Deputy Platform")
+
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "Deputy Platform", res.GetName())
+}
+
+func TestClient_DestroyTeam(t *testing.T) {
+	vcr := fixture("fixtures/team/destroy_team")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.DestroyTeam()
+
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, 200, res.GetStatusCode())
+}
+
+func TestClient_DestroyTeamError(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		return stubResponse(403, `{"error":{"error_msg":"Your team still has other members","error_name":"forbidden"}}`), nil
+	})
+
+	res, err := client.DestroyTeam()
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, "forbidden: Your team still has other members")
+}
+
+func TestClient_AddMemberToTeam(t *testing.T) {
+	vcr := fixture("fixtures/team/add_member_to_team")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.AddMemberToTeam("", "teammate@hellosign.com")
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	require.Len(t, res.GetAccounts(), 2)
+	assert.Equal(t, "teammate@hellosign.com", res.GetAccounts()[1].GetEmailAddress())
+}
+
+func TestClient_RemoveMemberFromTeam(t *testing.T) {
+	vcr := fixture("fixtures/team/remove_member_from_team")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.RemoveMemberFromTeam("d3f2c27a1b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c", "", "")
+
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	require.Len(t, res.GetAccounts(), 1)
+	assert.Equal(t, "me@hellosign.com", res.GetAccounts()[0].GetEmailAddress())
+}
+
+func TestClient_RemoveMemberFromTeamNewOwner(t *testing.T) {
+	var values map[string][]string
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		values = readRequestForm(t, r).Value
+		return stubResponse(200, `{"team":{"name":"Deputy Engineering"}}`), nil
+	})
+
+	_, err := client.RemoveMemberFromTeam("", "teammate@hellosign.com", "me@hellosign.com")
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, []string{"teammate@hellosign.com"}, values[EmailKey])
+	assert.Equal(t, []string{"me@hellosign.com"}, values[NewOwnerEmailKey])
+	assert.NotContains(t, values, AccountIDKey)
+}
+
+func TestClient_AddMemberToTeamRequiresOneIdentifier(t *testing.T) {
+	client := Client{APIKey: "api_key"}
+
+	_, err := client.AddMemberToTeam("d3f2c27a1b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c", "teammate@hellosign.com")
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, "exactly one of account_id or email_address must be provided", err.Error())
+}
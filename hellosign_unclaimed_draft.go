@@ -0,0 +1,89 @@
+package hellosign
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"net/http"
+)
+
+// CreateUnclaimedDraft creates a draft which can be claimed by following its ClaimURL.
+// When req.Type is empty it defaults to request_signature if signers are given, otherwise send_document.
+func (m *Client) CreateUnclaimedDraft(req model.UnclaimedDraftRequest) (*model.UnclaimedDraft, error) {
+	if req.Type == "" {
+		req.Type = m.defaultUnclaimedDraftType(req.GetSigners())
+	}
+
+	params, writer, err := m.marshalMultipartSignatureRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post("unclaimed_draft/create", params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseUnclaimedDraftResponse(response)
+}
+
+// CreateEmbeddedUnclaimedDraft creates a draft which can be claimed in an embedded iFrame on your site.
+// The ClientID is required; req.Type defaults the same way as CreateUnclaimedDraft.
+func (m *Client) CreateEmbeddedUnclaimedDraft(req model.EmbeddedUnclaimedDraftRequest) (*model.UnclaimedDraft, error) {
+	if req.GetClientID() == "" {
+		return nil, fmt.Errorf("%s is required for embedded unclaimed drafts", ClientIDKey)
+	}
+
+	if req.Type == "" {
+		req.Type = m.defaultUnclaimedDraftType(req.GetSigners())
+	}
+
+	params, writer, err := m.marshalMultipartSignatureRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post("unclaimed_draft/create_embedded", params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseUnclaimedDraftResponse(response)
+}
+
+// CreateEmbeddedUnclaimedDraftWithTemplate creates an embedded draft from one or more templates which the requester can edit before sending.
+func (m *Client) CreateEmbeddedUnclaimedDraftWithTemplate(req model.EmbeddedUnclaimedDraftWithTemplateRequest, signerRoles []model.SignerRole) (*model.UnclaimedDraft, error) {
+	if req.GetClientID() == "" {
+		return nil, fmt.Errorf("%s is required for embedded unclaimed drafts", ClientIDKey)
+	}
+
+	params, writer, err := m.marshalMultipartSignatureWithTemplateRequest(req, signerRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.post("unclaimed_draft/create_embedded_with_template", params, *writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.parseUnclaimedDraftResponse(response)
+}
+
+// defaultUnclaimedDraftType – Drafts with signers become signature requests, otherwise the claimer picks the signers
+func (m *Client) defaultUnclaimedDraftType(signers []model.Signer) string {
+	if len(signers) > 0 {
+		return model.UnclaimedDraftTypeRequestSignature
+	}
+	return model.UnclaimedDraftTypeSendDocument
+}
+
+// parseUnclaimedDraftResponse – Parses the unclaimed draft response and converts it into the unclaimed draft model
+func (m *Client) parseUnclaimedDraftResponse(response *http.Response) (*model.UnclaimedDraft, error) {
+	defer response.Body.Close()
+
+	draftResponse := &model.UnclaimedDraftResponse{}
+	err := json.NewDecoder(response.Body).Decode(draftResponse)
+
+	return draftResponse.GetUnclaimedDraft(), err
+}
@@ -0,0 +1,176 @@
+package hellosign
+
+import (
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mime/multipart"
+	"net/http"
+	"testing"
+)
+
+func TestClient_CreateUnclaimedDraft(t *testing.T) {
+	vcr := fixture("fixtures/unclaimed_draft/create_unclaimed_draft")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.CreateUnclaimedDraft(createUnclaimedDraftRequest())
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "5a3b0e7c9d1f2a4b6c8d0e1f3a5b7c9d2e4f6a8b", res.GetSignatureRequestID())
+	assert.Contains(t, res.GetClaimURL(), "https://app.hellosign.com/send/resendDocs?")
+	assert.Equal(t, 1505336477, res.GetExpiresAt())
+}
+
+func TestClient_CreateUnclaimedDraftDefaultType(t *testing.T) {
+	var form *multipart.Form
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		form = readRequestForm(t, r)
+		return stubResponse(200, `{"unclaimed_draft":{"claim_url":"https://app.hellosign.com/send/resendDocs"}}`), nil
+	})
+
+	withSigners := createUnclaimedDraftRequest()
+	_, err := client.CreateUnclaimedDraft(withSigners)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{model.UnclaimedDraftTypeRequestSignature}, form.Value["type"])
+
+	withoutSigners := createUnclaimedDraftRequest()
+	withoutSigners.Signers = nil
+	_, err = client.CreateUnclaimedDraft(withoutSigners)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{model.UnclaimedDraftTypeSendDocument}, form.Value["type"])
+
+	explicit := createUnclaimedDraftRequest()
+	explicit.Type = model.UnclaimedDraftTypeSendDocument
+	_, err = client.CreateUnclaimedDraft(explicit)
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, []string{model.UnclaimedDraftTypeSendDocument}, form.Value["type"])
+}
+
+func TestClient_CreateEmbeddedUnclaimedDraft(t *testing.T) {
+	vcr := fixture("fixtures/unclaimed_draft/create_embedded_unclaimed_draft")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.CreateEmbeddedUnclaimedDraft(createEmbeddedUnclaimedDraftRequest())
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "8c2e4f6a0b1d3f5a7c9e2b4d6f8a0c1e3b5d7f9a", res.GetSignatureRequestID())
+	assert.Contains(t, res.GetClaimURL(), "https://embedded.hellosign.com/prep-and-send/embedded-request?cached_params_token=")
+	assert.Contains(t, res.GetClaimURL(), "is_for_embedded_signing=1")
+	assert.Equal(t, "https://example.com/requested", res.GetRequestingRedirectURL())
+}
+
+func TestClient_EmbeddedUnclaimedDraftRequestMarshalling(t *testing.T) {
+	client := Client{}
+
+	params, writer, err := client.marshalMultipartSignatureRequest(createEmbeddedUnclaimedDraftRequest())
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"requester@example.com"}, form.Value["requester_email_address"])
+	assert.Equal(t, []string{"1"}, form.Value["is_for_embedded_signing"])
+	assert.Equal(t, []string{"https://example.com/requested"}, form.Value["requesting_redirect_url"])
+}
+
+func TestClient_CreateEmbeddedUnclaimedDraftRequiresClientID(t *testing.T) {
+	client := Client{}
+
+	req := createEmbeddedUnclaimedDraftRequest()
+	req.ClientID = ""
+
+	res, err := client.CreateEmbeddedUnclaimedDraft(req)
+	assert.Nil(t, res, "Should not return response")
+	assert.EqualError(t, err, "client_id is required for embedded unclaimed drafts")
+}
+
+func TestClient_CreateEmbeddedUnclaimedDraftWithTemplate(t *testing.T) {
+	vcr := fixture("fixtures/unclaimed_draft/create_embedded_unclaimed_draft_with_template")
+	defer vcr.Stop()
+
+	client := createVcrClient(vcr)
+	res, err := client.CreateEmbeddedUnclaimedDraftWithTemplate(createEmbeddedUnclaimedDraftWithTemplateRequest(), employeeSignerRoles())
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "1f3a5c7e9b2d4f6a8c0e1b3d5f7a9c2e4b6d8f0a", res.GetSignatureRequestID())
+	assert.Contains(t, res.GetClaimURL(), "https://embedded.hellosign.com/prep-and-send/embedded-request?cached_params_token=")
+}
+
+func TestClient_EmbeddedUnclaimedDraftWithTemplateRequestMarshalling(t *testing.T) {
+	client := Client{}
+
+	params, writer, err := client.marshalMultipartSignatureWithTemplateRequest(createEmbeddedUnclaimedDraftWithTemplateRequest(), employeeSignerRoles())
+	require.Nil(t, err, "Should not return error")
+
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"fc47b729f5611a75894680947c573f8a09fcb52c"}, form.Value["template_ids[0]"])
+	assert.Equal(t, []string{"76a888f4ca1dc1f726cbfd3381d7b9a19066c047"}, form.Value["template_ids[1]"])
+	assert.Equal(t, []string{"requester@example.com"}, form.Value["requester_email_address"])
+	assert.Equal(t, []string{"$120,000"}, form.Value["custom_fields[Salary]"])
+	assert.Equal(t, []string{"2017-10-01"}, form.Value["custom_fields[Start Date]"])
+	assert.Equal(t, []string{"jane@example.com"}, form.Value["signers[Employee][email_address]"])
+}
+
+func createEmbeddedUnclaimedDraftWithTemplateRequest() model.EmbeddedUnclaimedDraftWithTemplateRequest {
+	return model.EmbeddedUnclaimedDraftWithTemplateRequest{
+		TestMode:              true,
+		ClientID:              "0dd3b823a682527788c4e40cb7b6f7e9",
+		RequesterEmailAddress: "requester@example.com",
+		TemplateIDs: []string{
+			"fc47b729f5611a75894680947c573f8a09fcb52c",
+			"76a888f4ca1dc1f726cbfd3381d7b9a19066c047",
+		},
+		Signers: []model.Signer{
+			{
+				Email: "jane@example.com",
+				Name:  "Jane Doe",
+			},
+		},
+		CustomFields: map[string]string{
+			"Salary":     "$120,000",
+			"Start Date": "2017-10-01",
+		},
+	}
+}
+
+func employeeSignerRoles() []model.SignerRole {
+	return []model.SignerRole{
+		{
+			Name: "Employee",
+		},
+	}
+}
+
+func createEmbeddedUnclaimedDraftRequest() model.EmbeddedUnclaimedDraftRequest {
+	return model.EmbeddedUnclaimedDraftRequest{
+		TestMode:              true,
+		ClientID:              "0dd3b823a682527788c4e40cb7b6f7e9",
+		RequesterEmailAddress: "requester@example.com",
+		FileURL:               []string{"http://www.pdf995.com/samples/pdf.pdf"},
+		RequestingRedirectURL: "https://example.com/requested",
+		IsForEmbeddedSigning:  true,
+		Signers: []model.Signer{
+			{
+				Email: "jane@example.com",
+				Name:  "Jane Doe",
+			},
+		},
+	}
+}
+
+func createUnclaimedDraftRequest() model.UnclaimedDraftRequest {
+	return model.UnclaimedDraftRequest{
+		TestMode: true,
+		FileURL:  []string{"http://www.pdf995.com/samples/pdf.pdf"},
+		Subject:  "Contract",
+		Signers: []model.Signer{
+			{
+				Email: "jane@example.com",
+				Name:  "Jane Doe",
+			},
+		},
+	}
+}
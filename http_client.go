@@ -2,76 +2,244 @@ package hellosign
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"io"
+	"io/ioutil"
+	"mime"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 func (m *Client) get(path string) (*http.Response, error) {
-	endpoint := fmt.Sprintf("%s%s", m.getEndpoint(), path)
-
-	var b bytes.Buffer
-	request, _ := http.NewRequest("GET", endpoint, &b)
-	request.SetBasicAuth(m.APIKey, "")
-
-	response, err := m.getHTTPClient().Do(request)
+	response, err := m.do("GET", path, nil, "")
 	if err != nil {
 		return nil, err
 	}
 
+	if err := m.checkResponse(response); err != nil {
+		return nil, err
+	}
+
 	return response, err
 }
 
+// getWithQuery – Gets path with the query values appended, leaving the path unchanged when there are none
+func (m *Client) getWithQuery(path string, query url.Values) (*http.Response, error) {
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
+	}
+	return m.get(path)
+}
+
+// listParams encodes model.ListParams for the list endpoints
+type listParams model.ListParams
+
+// encode – Returns the non-zero params as query values, so HelloSign's defaults apply to the rest
+func (p listParams) encode() url.Values {
+	query := url.Values{}
+	if p.Page > 0 {
+		query.Set("page", strconv.Itoa(p.Page))
+	}
+	if p.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(p.PageSize))
+	}
+	if p.Query != "" {
+		query.Set("query", p.Query)
+	}
+	if p.AccountID != "" {
+		query.Set("account_id", p.AccountID)
+	}
+	return query
+}
+
 func (m *Client) post(path string, params *bytes.Buffer, w multipart.Writer) (*http.Response, error) {
 	return m.request("POST", path, params, w)
 }
 
+// request – Sends the marshalled multipart params. Only their bytes are read, so params can be sent again afterwards.
 func (m *Client) request(method string, path string, params *bytes.Buffer, w multipart.Writer) (*http.Response, error) {
-	endpoint := fmt.Sprintf("%s%s", m.getEndpoint(), path)
-	request, _ := http.NewRequest(method, endpoint, params)
-	request.Header.Add("Content-Type", w.FormDataContentType())
-	request.SetBasicAuth(m.APIKey, "")
-
-	response, err := m.getHTTPClient().Do(request)
+	response, err := m.do(method, path, params.Bytes(), w.FormDataContentType())
 	if err != nil {
 		return nil, err
 	}
 
-	if response.StatusCode >= 400 {
-		msg := fmt.Sprintf("hellosign request failed with status %d", response.StatusCode)
-		e := &model.ErrorResponse{}
-		json.NewDecoder(response.Body).Decode(e)
-		if e.Error != nil {
-			msg = fmt.Sprintf("%s: %s", e.Error.Name, e.Error.Message)
-		} else {
-			messages := []string{}
-			for _, w := range e.Warnings {
-				messages = append(messages, fmt.Sprintf("%s: %s", w.Name, w.Message))
-			}
-			msg = strings.Join(messages, ", ")
-		}
-
-		return response, errors.New(msg)
+	if err := m.checkResponse(response); err != nil {
+		return nil, err
 	}
 
 	return response, err
 }
 
+// newMultipartWriter – Creates a multipart writer for w with the Client's MultipartBoundary, or a random boundary when it's unset
+func (m *Client) newMultipartWriter(w io.Writer) (*multipart.Writer, error) {
+	writer := multipart.NewWriter(w)
+	if m.MultipartBoundary != "" {
+		if err := writer.SetBoundary(m.MultipartBoundary); err != nil {
+			return nil, err
+		}
+	}
+	return writer, nil
+}
+
 func (m *Client) nakedPost(path string) (*http.Response, error) {
-	endpoint := fmt.Sprintf("%s%s", m.getEndpoint(), path)
-	var b bytes.Buffer
-	request, _ := http.NewRequest("POST", endpoint, &b)
+	return m.do("POST", path, nil, "")
+}
+
+func (m *Client) delete(path string) (*http.Response, error) {
+	return m.do("DELETE", path, nil, "")
+}
+
+// do – Sends the request, retrying according to the RetryPolicy. The body is kept as bytes so every attempt can resend it.
+func (m *Client) do(method string, path string, body []byte, contentType string) (*http.Response, error) {
+	if m.APIKey == "" && m.AccessToken == "" {
+		return nil, errors.New("an APIKey or AccessToken is required")
+	}
+
+	return m.doEndpoint(method, fmt.Sprintf("%s%s", m.getEndpoint(), path), body, contentType, true)
+}
+
+// doEndpoint – Sends the request to an absolute endpoint, waiting on the RateLimiter before every attempt.
+// authenticate is false for endpoints outside the v3 API that must not receive the client's credentials.
+func (m *Client) doEndpoint(method string, endpoint string, body []byte, contentType string, authenticate bool) (*http.Response, error) {
+	ctx := m.getContext()
+
+	for attempt := 0; ; attempt++ {
+		if err := m.waitRateLimiter(ctx); err != nil {
+			return nil, err
+		}
+
+		request, err := http.NewRequest(method, endpoint, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		request = request.WithContext(ctx)
+		if contentType != "" {
+			request.Header.Set("Content-Type", contentType)
+		}
+		request.Header.Set("User-Agent", m.getUserAgent())
+		m.applyRequestOptions(request)
+		if authenticate {
+			m.setAuthorization(request)
+		}
+
+		response, err := m.getHTTPClient().Do(request)
+		if err != nil {
+			return nil, err
+		}
+		m.recordRateLimit(response)
+
+		wait, retry := m.RetryPolicy.retryAfter(attempt, response)
+		if !retry {
+			return response, nil
+		}
+
+		response.Body.Close()
+		if err := m.sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sleepContext – Waits for d, returning ctx's error early if it is done first
+func (m *Client) sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// LastRateLimit returns the rate limit reported by the most recent response, or nil before any response carried one
+func (m *Client) LastRateLimit() *model.RateLimit {
+	rateLimit, _ := m.lastRateLimit.Load().(*model.RateLimit)
+	return rateLimit
+}
+
+// recordRateLimit – Keeps the X-Ratelimit headers of the response for LastRateLimit
+func (m *Client) recordRateLimit(response *http.Response) {
+	limit, err := strconv.Atoi(response.Header.Get("X-Ratelimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, _ := strconv.Atoi(response.Header.Get("X-Ratelimit-Limit-Remaining"))
+	reset, _ := strconv.Atoi(response.Header.Get("X-Ratelimit-Reset"))
+
+	m.lastRateLimit.Store(&model.RateLimit{Limit: limit, Remaining: remaining, Reset: reset})
+}
+
+// setAuthorization – Authenticates with the OAuth AccessToken when present, otherwise with the APIKey
+func (m *Client) setAuthorization(request *http.Request) {
+	if m.AccessToken != "" {
+		request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", m.AccessToken))
+		return
+	}
 	request.SetBasicAuth(m.APIKey, "")
+}
+
+// checkResponse – Converts 4xx and 5xx responses into a *model.APIError, closing the body as it is no longer needed
+func (m *Client) checkResponse(response *http.Response) error {
+	if response.StatusCode < 400 {
+		return nil
+	}
+	defer response.Body.Close()
+
+	// Gateways and maintenance pages answer with HTML, which would only produce a JSON syntax error
+	contentType := response.Header.Get("Content-Type")
+	if contentType != "" && !m.isJSONContentType(contentType) {
+		snippet, _ := ioutil.ReadAll(io.LimitReader(response.Body, maxErrorSnippetSize))
+		return &UnexpectedResponseError{
+			StatusCode:  response.StatusCode,
+			ContentType: contentType,
+			Body:        string(snippet),
+		}
+	}
+
+	apiErr := &model.APIError{StatusCode: response.StatusCode}
+
+	e := &model.ErrorResponse{}
+	json.NewDecoder(io.LimitReader(response.Body, maxErrorBodySize)).Decode(e)
+	if e.Error != nil {
+		apiErr.ErrorName = e.Error.GetName()
+		apiErr.ErrorMsg = e.Error.GetMessage()
+	}
+	apiErr.Warnings = e.GetWarnings()
+
+	return apiErr
+}
 
-	response, err := m.getHTTPClient().Do(request)
+const (
+	maxErrorBodySize    = 1 << 20 // Error envelopes are tiny, anything bigger isn't one.
+	maxErrorSnippetSize = 512
+)
+
+// UnexpectedResponseError is returned when HelloSign, or a proxy in front of it, responds with a 4xx or 5xx status and a body that isn't JSON
+type UnexpectedResponseError struct {
+	StatusCode  int    // The HTTP status code of the response.
+	ContentType string // The Content-Type of the response, eg: text/html
+	Body        string // The start of the body, at most 512 bytes.
+}
+
+// Error includes the start of the body, which usually names the gateway or maintenance page
+func (e *UnexpectedResponseError) Error() string {
+	return fmt.Sprintf("hellosign request failed with status %d and a %s body: %s", e.StatusCode, e.ContentType, e.Body)
+}
+
+// isJSONContentType – Reports whether contentType is application/json or a +json type
+func (m *Client) isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
 	if err != nil {
-		return nil, err
+		return false
 	}
-	return response, err
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
 }
 
 func (m *Client) getEndpoint() string {
@@ -84,12 +252,41 @@ func (m *Client) getEndpoint() string {
 	return url
 }
 
-func (m *Client) getHTTPClient() *http.Client {
-	var httpClient *http.Client
+// defaultHTTPClient is shared by clients without an HTTPClient so a hung request cannot block forever
+var defaultHTTPClient = &http.Client{Timeout: defaultTimeout}
+
+func (m *Client) getUserAgent() string {
+	if m.UserAgent != "" {
+		return m.UserAgent
+	}
+	return defaultUserAgent
+}
+
+// RateLimiter blocks until another request may be sent, as *rate.Limiter from golang.org/x/time/rate does.
+// Sharing one between goroutines keeps a Client under HelloSign's per-account rate limits.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// waitRateLimiter – Waits for the RateLimiter, if any, giving up when ctx is done
+func (m *Client) waitRateLimiter(ctx context.Context) error {
+	if m.RateLimiter == nil {
+		return nil
+	}
+	return m.RateLimiter.Wait(ctx)
+}
+
+// Doer sends an HTTP request, as *http.Client does. Setting Client.HTTPClient to a stub Doer lets code using the SDK be tested without the API.
+type Doer interface {
+	Do(request *http.Request) (*http.Response, error)
+}
+
+func (m *Client) getHTTPClient() Doer {
+	var httpClient Doer
 	if m.HTTPClient != nil {
 		httpClient = m.HTTPClient
 	} else {
-		httpClient = &http.Client{}
+		httpClient = defaultHTTPClient
 	}
 	return httpClient
 }
@@ -0,0 +1,508 @@
+package hellosign
+
+import (
+	"context"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_AuthorizationHeader(t *testing.T) {
+	cases := []struct {
+		name        string
+		apiKey      string
+		accessToken string
+		expected    string
+	}{
+		{name: "api key", apiKey: "api_key", expected: "Basic YXBpX2tleTo="},
+		{name: "access token", accessToken: "oauth_token", expected: "Bearer oauth_token"},
+		{name: "both prefers access token", apiKey: "api_key", accessToken: "oauth_token", expected: "Bearer oauth_token"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var authorization string
+			client := createStubClient(func(r *http.Request) (*http.Response, error) {
+				authorization = r.Header.Get("Authorization")
+				return stubResponse(200, `{"account":{}}`), nil
+			})
+			client.APIKey = c.apiKey
+			client.AccessToken = c.accessToken
+
+			_, err := client.GetAccount()
+			require.Nil(t, err, "Should not return error")
+			assert.Equal(t, c.expected, authorization)
+		})
+	}
+}
+
+func TestClient_RetryPolicyRetriesTooManyRequests(t *testing.T) {
+	var emails []string
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		emails = append(emails, readRequestForm(t, r).Value["email_address"][0])
+		if len(emails) == 1 {
+			response := stubResponse(429, `{"error":{"error_msg":"Rate limit exceeded","error_name":"exceeded_rate"}}`)
+			response.Header.Set("Retry-After", "0")
+			return response, nil
+		}
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"abc123"}}`), nil
+	})
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 2}
+
+	res, err := client.RemindSignatureRequest("abc123", "freddy@hellosign.com")
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "abc123", res.GetSignatureRequestID())
+	assert.Equal(t, []string{"freddy@hellosign.com", "freddy@hellosign.com"}, emails, "Should resend the same body on retry")
+}
+
+func TestClient_RetryPolicyStopsAfterMaxRetries(t *testing.T) {
+	var attempts []int
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		return stubResponse(503, `{"error":{"error_msg":"Unavailable","error_name":"unavailable"}}`), nil
+	})
+	client.RetryPolicy = &RetryPolicy{
+		MaxRetries: 2,
+		Backoff: func(attempt int) time.Duration {
+			attempts = append(attempts, attempt)
+			return 0
+		},
+	}
+
+	res, err := client.GetAccount()
+	assert.Nil(t, res, "Should not return response")
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, 503, err.(*model.APIError).StatusCode)
+	assert.Equal(t, []int{0, 1}, attempts, "Should back off before each retry")
+}
+
+func TestClient_NoRetryPolicyDoesNotRetry(t *testing.T) {
+	calls := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return stubResponse(429, `{"error":{"error_msg":"Rate limit exceeded","error_name":"exceeded_rate"}}`), nil
+	})
+
+	_, err := client.GetAccount()
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, 1, calls)
+}
+
+func TestDefaultBackoff(t *testing.T) {
+	for attempt := 0; attempt < 4; attempt++ {
+		base := 500 * time.Millisecond << uint(attempt)
+		wait := DefaultBackoff(attempt)
+		assert.True(t, wait >= base && wait <= base+base/2, "Should grow exponentially with jitter")
+	}
+}
+
+func TestClient_DefaultsToProductionURL(t *testing.T) {
+	var url string
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		url = r.URL.String()
+		return stubResponse(200, `{"account":{}}`), nil
+	})
+
+	_, err := client.GetAccount()
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "https://api.hellosign.com/v3/account", url)
+}
+
+func TestClient_RequiresCredentials(t *testing.T) {
+	calls := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return stubResponse(200, `{"account":{}}`), nil
+	})
+	client.APIKey = ""
+
+	res, err := client.GetAccount()
+	assert.Nil(t, res, "Should not return response")
+	require.NotNil(t, err, "Should return error")
+	assert.Equal(t, "an APIKey or AccessToken is required", err.Error())
+	assert.Equal(t, 0, calls, "Should not send the request")
+}
+
+func TestNewClient(t *testing.T) {
+	client := NewClient("api_key")
+
+	assert.Equal(t, "api_key", client.APIKey)
+	assert.Equal(t, "https://api.hellosign.com/v3/", client.BaseURL)
+	httpClient, ok := client.HTTPClient.(*http.Client)
+	require.True(t, ok, "Should use an http.Client")
+	assert.Equal(t, 30*time.Second, httpClient.Timeout)
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	t.Setenv("HELLOSIGN_API_KEY", "api_key")
+	t.Setenv("HELLOSIGN_BASE_URL", "")
+
+	client, err := NewClientFromEnv()
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "api_key", client.APIKey)
+	assert.Equal(t, "https://api.hellosign.com/v3/", client.BaseURL, "Should default to production")
+	httpClient, ok := client.HTTPClient.(*http.Client)
+	require.True(t, ok, "Should use an http.Client")
+	assert.Equal(t, 30*time.Second, httpClient.Timeout)
+
+	t.Setenv("HELLOSIGN_BASE_URL", "https://hellosign.example.com/v3")
+	client, err = NewClientFromEnv()
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "https://hellosign.example.com/v3/", client.BaseURL)
+}
+
+func TestNewClientFromEnvMissingAPIKey(t *testing.T) {
+	t.Setenv("HELLOSIGN_API_KEY", "")
+
+	client, err := NewClientFromEnv()
+	assert.Nil(t, client, "Should not return client")
+	assert.EqualError(t, err, "HELLOSIGN_API_KEY is not set")
+}
+
+func TestNewClientWithHTTPClient(t *testing.T) {
+	hc := &http.Client{
+		Timeout: time.Nanosecond,
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			<-r.Context().Done()
+			return nil, r.Context().Err()
+		}),
+	}
+	client := NewClientWithHTTPClient("api_key", hc)
+
+	assert.Equal(t, hc, client.HTTPClient)
+
+	res, err := client.GetAccount()
+	assert.Nil(t, res, "Should not return response")
+	require.NotNil(t, err, "Should return error")
+
+	netErr, ok := err.(net.Error)
+	require.True(t, ok, "Should return a net.Error")
+	assert.True(t, netErr.Timeout(), "Should time out")
+}
+
+func TestClient_DefaultHTTPClientTimesOut(t *testing.T) {
+	client := Client{APIKey: "api_key"}
+
+	assert.Equal(t, defaultHTTPClient, client.getHTTPClient())
+	assert.Equal(t, 30*time.Second, defaultHTTPClient.Timeout)
+}
+
+func TestClient_RequestResendsMarshalledPayload(t *testing.T) {
+	var bodies []string
+	var contentTypes []string
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+		return stubResponse(200, `{"signature_request":{}}`), nil
+	})
+
+	params, writer, err := client.marshalMultipartAccountIdentifier("", "freddy@hellosign.com", nil)
+	require.Nil(t, err, "Should not return error")
+
+	for i := 0; i < 2; i++ {
+		_, err := client.post("template/add_user/abc123", params, *writer)
+		require.Nil(t, err, "Should not return error")
+	}
+
+	require.Len(t, bodies, 2)
+	assert.Contains(t, bodies[0], "freddy@hellosign.com")
+	assert.Equal(t, bodies[0], bodies[1], "Should send identical bodies")
+	assert.Equal(t, writer.FormDataContentType(), contentTypes[0])
+	assert.Equal(t, contentTypes[0], contentTypes[1])
+}
+
+func TestClient_UserAgentHeader(t *testing.T) {
+	cases := []struct {
+		name      string
+		userAgent string
+		expected  string
+	}{
+		{name: "default", expected: "hellosign-go-sdk/" + Version},
+		{name: "override", userAgent: "billing-service/2.3", expected: "billing-service/2.3"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var userAgent string
+			client := createStubClient(func(r *http.Request) (*http.Response, error) {
+				userAgent = r.Header.Get("User-Agent")
+				return stubResponse(200, `{"account":{}}`), nil
+			})
+			client.UserAgent = c.userAgent
+
+			_, err := client.GetAccount()
+			require.Nil(t, err, "Should not return error")
+			assert.Equal(t, c.expected, userAgent)
+		})
+	}
+}
+
+func TestClient_LastRateLimit(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		response := stubResponse(200, `{"account":{}}`)
+		response.Header.Set("X-Ratelimit-Limit", "2000")
+		response.Header.Set("X-Ratelimit-Limit-Remaining", "1997")
+		response.Header.Set("X-Ratelimit-Reset", "1505249705")
+		return response, nil
+	})
+
+	assert.Nil(t, client.LastRateLimit(), "Should be nil before any request")
+
+	_, err := client.GetAccount()
+	require.Nil(t, err, "Should not return error")
+
+	rateLimit := client.LastRateLimit()
+	require.NotNil(t, rateLimit, "Should record the rate limit")
+	assert.Equal(t, 2000, rateLimit.GetLimit())
+	assert.Equal(t, 1997, rateLimit.GetRemaining())
+	assert.Equal(t, time.Unix(1505249705, 0), rateLimit.GetResetTime())
+}
+
+func TestClient_WithRequestOptions(t *testing.T) {
+	var request *http.Request
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		request = r
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353"}}`), nil
+	})
+
+	headers := http.Header{}
+	headers.Set("X-Correlation-ID", "c0ffee")
+	headers.Set("Authorization", "Bearer gateway-token")
+
+	res, err := client.WithRequestOptions(RequestOptions{Headers: headers}).GetSignatureRequest("6d7ad140141a7fe6874fec55931c363e0301c353")
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "6d7ad140141a7fe6874fec55931c363e0301c353", res.GetSignatureRequestID())
+
+	assert.Equal(t, "c0ffee", request.Header.Get("X-Correlation-ID"))
+	username, _, ok := request.BasicAuth()
+	assert.True(t, ok, "Should keep the APIKey authorization")
+	assert.Equal(t, "api_key", username)
+
+	_, err = client.GetSignatureRequest("6d7ad140141a7fe6874fec55931c363e0301c353")
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "", request.Header.Get("X-Correlation-ID"), "Should not change the original client")
+}
+
+func TestClient_OnBehalfOf(t *testing.T) {
+	queries := []url.Values{}
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		queries = append(queries, r.URL.Query())
+		if r.URL.Path == "/v3/template/list" {
+			return stubResponse(200, `{"list_info":{"page":1,"num_pages":1},"templates":[]}`), nil
+		}
+		return stubResponse(200, `{"list_info":{"page":1,"num_pages":1},"signature_requests":[]}`), nil
+	})
+	member := client.WithRequestOptions(RequestOptions{OnBehalfOf: "5008b25c7f67153e57d5a357b1687968068fb465"})
+
+	_, err := member.FindSignatureRequestsByMetadata("order_id", "A-42")
+	require.Nil(t, err, "Should not return error")
+	_, err = member.ListTemplatesWithParams(model.ListParams{AccountID: "all"})
+	require.Nil(t, err, "Should not return error")
+	_, err = client.ListSignatureRequests()
+	require.Nil(t, err, "Should not return error")
+
+	require.Len(t, queries, 3)
+	assert.Equal(t, "5008b25c7f67153e57d5a357b1687968068fb465", queries[0].Get("account_id"), "Should scope helpers to the account")
+	assert.Equal(t, "all", queries[1].Get("account_id"), "Should prefer the AccountID in the params")
+	assert.NotContains(t, queries[2], "account_id", "Should not change the original client")
+}
+
+func TestClient_RateLimiterSpacesRequests(t *testing.T) {
+	var mu sync.Mutex
+	sent := []time.Time{}
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		mu.Lock()
+		sent = append(sent, time.Now())
+		mu.Unlock()
+		return stubResponse(200, `{"account":{}}`), nil
+	})
+	interval := 20 * time.Millisecond
+	client.RateLimiter = &intervalLimiter{interval: interval}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetAccount()
+			assert.Nil(t, err, "Should not return error")
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, sent, 4, "Should send every request")
+	sort.Slice(sent, func(i, j int) bool { return sent[i].Before(sent[j]) })
+	for i := 1; i < len(sent); i++ {
+		assert.True(t, sent[i].Sub(sent[i-1]) >= interval-time.Millisecond, "Should space requests by the configured rate")
+	}
+}
+
+func TestClient_RateLimiterRespectsContext(t *testing.T) {
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		t.Fatal("Should not send the request")
+		return nil, nil
+	})
+	client.RateLimiter = &intervalLimiter{interval: time.Hour, next: time.Now().Add(time.Hour)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.WithRequestOptions(RequestOptions{Context: ctx}).GetAccount()
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestClient_RetryWaitRespectsContext(t *testing.T) {
+	attempts := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		response := stubResponse(429, `{"error":{"error_msg":"Rate limit exceeded","error_name":"exceeded_rate"}}`)
+		response.Header.Set("Retry-After", "3600")
+		return response, nil
+	})
+	client.RetryPolicy = &RetryPolicy{MaxRetries: 2}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	_, err := client.WithRequestOptions(RequestOptions{Context: ctx}).GetAccount()
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(started) < time.Second, "Should stop waiting once the context is done")
+	assert.Equal(t, 1, attempts, "Should not retry after the context is done")
+}
+
+// intervalLimiter lets one request through per interval, like a rate.Limiter with a burst of 1
+type intervalLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func (l *intervalLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stubDoer returns the same canned response to every request
+type stubDoer struct {
+	requests []*http.Request
+	body     string
+}
+
+func (d *stubDoer) Do(request *http.Request) (*http.Response, error) {
+	d.requests = append(d.requests, request)
+	return stubResponse(200, d.body), nil
+}
+
+func TestNewClientWithDoer(t *testing.T) {
+	doer := &stubDoer{body: `{"signature_request":{"signature_request_id":"6d7ad140141a7fe6874fec55931c363e0301c353","title":"Offer Letter","is_complete":true}}`}
+	client := NewClientWithDoer("api_key", doer)
+
+	res, err := client.GetSignatureRequest("6d7ad140141a7fe6874fec55931c363e0301c353")
+	require.Nil(t, err, "Should not return error")
+	require.NotNil(t, res, "Should return response")
+
+	assert.Equal(t, "Offer Letter", res.GetTitle())
+	assert.True(t, res.GetIsComplete())
+	require.Len(t, doer.requests, 1)
+	assert.Equal(t, "https://api.hellosign.com/v3/signature_request/6d7ad140141a7fe6874fec55931c363e0301c353", doer.requests[0].URL.String())
+}
+
+func TestNewClientWithNilHTTPClient(t *testing.T) {
+	client := NewClientWithHTTPClient("api_key", nil)
+
+	assert.Nil(t, client.HTTPClient, "Should not keep a typed nil")
+	assert.Equal(t, defaultHTTPClient, client.getHTTPClient())
+}
+
+func TestListParamsEncode(t *testing.T) {
+	assert.Equal(t, url.Values{}, listParams{}.encode(), "Should omit zero params")
+	assert.Equal(t, "", listParams{Page: 0, PageSize: -1}.encode().Encode(), "Should omit non-positive paging")
+
+	params := listParams{Page: 2, PageSize: 50, Query: "metadata:order_id=A-42", AccountID: "all"}
+	assert.Equal(t, url.Values{
+		"page":       []string{"2"},
+		"page_size":  []string{"50"},
+		"query":      []string{"metadata:order_id=A-42"},
+		"account_id": []string{"all"},
+	}, params.encode())
+	assert.Equal(t, "account_id=all&page=2&page_size=50&query=metadata%3Aorder_id%3DA-42", params.encode().Encode())
+}
+
+func TestGetWithQuery(t *testing.T) {
+	urls := []string{}
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		urls = append(urls, r.URL.String())
+		return stubResponse(200, `{}`), nil
+	})
+
+	_, err := client.getWithQuery("template/list", url.Values{})
+	require.Nil(t, err, "Should not return error")
+	_, err = client.getWithQuery("template/list", listParams{Page: 3}.encode())
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, []string{
+		"https://api.hellosign.com/v3/template/list",
+		"https://api.hellosign.com/v3/template/list?page=3",
+	}, urls)
+}
+
+func TestCheckResponseNonJSONError(t *testing.T) {
+	page := "<html><head><title>502 Bad Gateway</title></head><body><center><h1>502 Bad Gateway</h1></center>" +
+		strings.Repeat("<!-- padding -->", 100) + "</body></html>"
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 502,
+			Header:     http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+			Body:       ioutil.NopCloser(strings.NewReader(page)),
+		}, nil
+	})
+
+	res, err := client.GetSignatureRequest("6d7ad140141a7fe6874fec55931c363e0301c353")
+	assert.Nil(t, res, "Should not return response")
+	require.NotNil(t, err, "Should return error")
+
+	unexpected, ok := err.(*UnexpectedResponseError)
+	require.True(t, ok, "Should return an UnexpectedResponseError, got %T", err)
+	assert.Equal(t, 502, unexpected.StatusCode)
+	assert.Equal(t, "text/html; charset=utf-8", unexpected.ContentType)
+	assert.Len(t, unexpected.Body, 512, "Should truncate the body")
+	assert.True(t, strings.HasPrefix(unexpected.Body, "<html><head><title>502 Bad Gateway</title>"))
+	assert.Contains(t, err.Error(), "hellosign request failed with status 502 and a text/html; charset=utf-8 body: <html>")
+}
+
+func TestCheckResponseJSONError(t *testing.T) {
+	for _, contentType := range []string{"application/json", "application/json; charset=utf-8", "application/problem+json", ""} {
+		client := createStubClient(func(r *http.Request) (*http.Response, error) {
+			res := stubResponse(400, `{"error":{"error_msg":"Invalid signature_request_id","error_name":"bad_request"}}`)
+			res.Header.Set("Content-Type", contentType)
+			return res, nil
+		})
+
+		_, err := client.GetSignatureRequest("6d7ad140141a7fe6874fec55931c363e0301c353")
+		require.IsType(t, &model.APIError{}, err, "Should decode the error envelope for %q", contentType)
+		assert.Equal(t, "bad_request: Invalid signature_request_id", err.Error())
+	}
+}
@@ -0,0 +1,129 @@
+package hellosign
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"sync"
+	"time"
+)
+
+// DefaultIdempotencyTTL is how long the default store remembers an idempotency key
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// defaultIdempotencyStore is used by clients that don't set an IdempotencyStore. Keys are scoped to the client's credential
+// by idempotencyKey, so clients for different accounts never see each other's signature requests.
+var defaultIdempotencyStore = NewMemoryIdempotencyStore(DefaultIdempotencyTTL)
+
+// idempotencyLocks serialises creates that share a scoped key, so concurrent retries don't both create a signature request
+var idempotencyLocks = &keyedMutex{locks: make(map[string]*keyedLock)}
+
+// IdempotencyStore records the signature request created for an idempotency key, so a retried create returns it instead of sending a duplicate
+type IdempotencyStore interface {
+	Get(key string) (*model.SignatureRequest, bool) // Returns the signature request recorded for key, unless it has expired.
+	Set(key string, signatureRequest *model.SignatureRequest)
+}
+
+// getIdempotencyStore – Returns the client's IdempotencyStore, falling back to the process wide default
+func (m *Client) getIdempotencyStore() IdempotencyStore {
+	if m.IdempotencyStore != nil {
+		return m.IdempotencyStore
+	}
+	return defaultIdempotencyStore
+}
+
+// idempotencyKey – Scopes key to the credential the client authenticates with, as setAuthorization picks it
+func (m *Client) idempotencyKey(key string) string {
+	credential := m.APIKey
+	if m.AccessToken != "" {
+		credential = m.AccessToken
+	}
+	sum := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(sum[:]) + ":" + key
+}
+
+// keyedMutex holds a lock per key, dropping it once no one holds or waits on it
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+// Lock blocks until key is free and returns the func that frees it
+func (k *keyedMutex) Lock(key string) func() {
+	k.mu.Lock()
+	lock, ok := k.locks[key]
+	if !ok {
+		lock = &keyedLock{}
+		k.locks[key] = lock
+	}
+	lock.refs++
+	k.mu.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+
+		k.mu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
+
+// MemoryIdempotencyStore is an IdempotencyStore that keeps keys in memory until their TTL passes
+type MemoryIdempotencyStore struct {
+	TTL time.Duration
+
+	mu      sync.Mutex
+	entries map[string]idempotencyEntry
+}
+
+type idempotencyEntry struct {
+	signatureRequest *model.SignatureRequest
+	expiresAt        time.Time
+}
+
+// NewMemoryIdempotencyStore creates a MemoryIdempotencyStore that remembers keys for ttl
+func NewMemoryIdempotencyStore(ttl time.Duration) *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		TTL:     ttl,
+		entries: make(map[string]idempotencyEntry),
+	}
+}
+
+// Get returns the signature request recorded for key, removing it once expired
+func (s *MemoryIdempotencyStore) Get(key string) (*model.SignatureRequest, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expiresAt) {
+		delete(s.entries, key)
+		return nil, false
+	}
+	return entry.signatureRequest, true
+}
+
+// Set records the signature request for key until the TTL passes
+func (s *MemoryIdempotencyStore) Set(key string, signatureRequest *model.SignatureRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.entries == nil {
+		s.entries = make(map[string]idempotencyEntry)
+	}
+	s.entries[key] = idempotencyEntry{
+		signatureRequest: signatureRequest,
+		expiresAt:        time.Now().Add(s.TTL),
+	}
+}
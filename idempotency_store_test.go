@@ -0,0 +1,120 @@
+package hellosign
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCreateEmbeddedSignatureRequestIdempotent(t *testing.T) {
+	posts := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		posts++
+		assert.Equal(t, "/v3/signature_request/create_embedded", r.URL.Path)
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"a9f4825edef25f47e7b4c14ce8100d81d1693160"}}`), nil
+	})
+	client.IdempotencyStore = NewMemoryIdempotencyStore(time.Hour)
+
+	res, err := client.CreateEmbeddedSignatureRequestIdempotent("offer-42", createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "a9f4825edef25f47e7b4c14ce8100d81d1693160", res.GetSignatureRequestID())
+	assert.Equal(t, 1, posts, "Should create the signature request")
+
+	replay, err := client.CreateEmbeddedSignatureRequestIdempotent("offer-42", createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, res, replay, "Should return the cached signature request")
+	assert.Equal(t, 1, posts, "Should not create a duplicate")
+
+	_, err = client.CreateEmbeddedSignatureRequestIdempotent("offer-43", createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, 2, posts, "Should create a signature request for a new key")
+}
+
+func TestCreateEmbeddedSignatureRequestIdempotentDoesNotRecordErrors(t *testing.T) {
+	posts := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		posts++
+		if posts == 1 {
+			return stubResponse(500, `{"error":{"error_msg":"Unknown error","error_name":"unknown"}}`), nil
+		}
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"a9f4825edef25f47e7b4c14ce8100d81d1693160"}}`), nil
+	})
+	client.IdempotencyStore = NewMemoryIdempotencyStore(time.Hour)
+
+	_, err := client.CreateEmbeddedSignatureRequestIdempotent("offer-42", createEmbeddedSignatureRequest())
+	assert.NotNil(t, err, "Should return error")
+
+	res, err := client.CreateEmbeddedSignatureRequestIdempotent("offer-42", createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, "a9f4825edef25f47e7b4c14ce8100d81d1693160", res.GetSignatureRequestID())
+	assert.Equal(t, 2, posts, "Should retry the create after an error")
+}
+
+func TestCreateEmbeddedSignatureRequestIdempotentScopedToCredential(t *testing.T) {
+	created := map[string]string{}
+	doer := func(r *http.Request) (*http.Response, error) {
+		username, _, _ := r.BasicAuth()
+		id := "a9f4825edef25f47e7b4c14ce8100d81d1693160"
+		if username == "other_api_key" {
+			id = "7b2d4f6a8c0e1b3d5f7a9c2e4b6d8f0a1c3e5b7d"
+		}
+		created[username] = id
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"`+id+`"}}`), nil
+	}
+	// Both share one store, as clients without an IdempotencyStore share the default
+	store := NewMemoryIdempotencyStore(time.Hour)
+	client := createStubClient(doer)
+	client.IdempotencyStore = store
+	other := createStubClient(doer)
+	other.APIKey = "other_api_key"
+	other.IdempotencyStore = store
+
+	res, err := client.CreateEmbeddedSignatureRequestIdempotent("offer-42", createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+	otherRes, err := other.CreateEmbeddedSignatureRequestIdempotent("offer-42", createEmbeddedSignatureRequest())
+	require.Nil(t, err, "Should not return error")
+
+	assert.Len(t, created, 2, "Should create a signature request for each credential")
+	assert.Equal(t, "a9f4825edef25f47e7b4c14ce8100d81d1693160", res.GetSignatureRequestID())
+	assert.Equal(t, "7b2d4f6a8c0e1b3d5f7a9c2e4b6d8f0a1c3e5b7d", otherRes.GetSignatureRequestID(), "Should not return the other client's signature request")
+}
+
+func TestCreateEmbeddedSignatureRequestIdempotentConcurrent(t *testing.T) {
+	var posts int32
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&posts, 1)
+		time.Sleep(10 * time.Millisecond)
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"a9f4825edef25f47e7b4c14ce8100d81d1693160"}}`), nil
+	})
+	client.IdempotencyStore = NewMemoryIdempotencyStore(time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.CreateEmbeddedSignatureRequestIdempotent("offer-42", createEmbeddedSignatureRequest())
+			assert.Nil(t, err, "Should not return error")
+			assert.Equal(t, "a9f4825edef25f47e7b4c14ce8100d81d1693160", res.GetSignatureRequestID())
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&posts), "Should create the signature request once")
+}
+
+func TestMemoryIdempotencyStoreExpires(t *testing.T) {
+	store := NewMemoryIdempotencyStore(time.Millisecond)
+	store.Set("offer-42", nil)
+
+	_, ok := store.Get("offer-42")
+	assert.True(t, ok, "Should return the recorded key within the TTL")
+
+	time.Sleep(5 * time.Millisecond)
+	_, ok = store.Get("offer-42")
+	assert.False(t, ok, "Should forget the key once the TTL passes")
+}
@@ -1,10 +1,23 @@
 package model
 
 // Account contains information about an account and its settings
-// Note: we ignore is_paid_hs, is_paid_hf, quotas, role_code
 type Account struct {
-	AccountID    string `json:"account_id"`
-	EmailAddress string `json:"email_address"`
+	AccountID    string  `json:"account_id"`
+	EmailAddress string  `json:"email_address"`
+	CallbackURL  string  `json:"callback_url"` // The URL that HelloSign events will be POSTed to.
+	IsLocked     bool    `json:"is_locked"`    // Returns true if the user has been locked out of their account by a team admin.
+	IsPaidHS     bool    `json:"is_paid_hs"`   // Returns true if the user has a paid HelloSign account.
+	IsPaidHF     bool    `json:"is_paid_hf"`   // Returns true if the user has a paid HelloFax account.
+	Quotas       *Quotas `json:"quotas"`       // Details concerning remaining monthly quotas.
+	RoleCode     string  `json:"role_code"`    // The membership role for the team. a = Admin, m = Member
+	Locale       string  `json:"locale"`       // The locale used in this Account.
+}
+
+// Quotas contains the remaining monthly quotas of an account
+type Quotas struct {
+	TemplatesLeft            int `json:"templates_left"`              // API templates remaining.
+	APISignatureRequestsLeft int `json:"api_signature_requests_left"` // API signature requests remaining.
+	DocumentsLeft            int `json:"documents_left"`              // Signature requests remaining.
 }
 
 // GetAccountID returns AccountID
@@ -21,4 +34,84 @@ func (a *Account) GetEmailAddress() string {
 		return a.EmailAddress
 	}
 	return ""
-}
\ No newline at end of file
+}
+
+// GetCallbackURL returns CallbackURL
+func (a *Account) GetCallbackURL() string {
+	if a != nil {
+		return a.CallbackURL
+	}
+	return ""
+}
+
+// GetIsLocked returns IsLocked
+func (a *Account) GetIsLocked() bool {
+	if a != nil {
+		return a.IsLocked
+	}
+	return false
+}
+
+// GetIsPaidHS returns IsPaidHS
+func (a *Account) GetIsPaidHS() bool {
+	if a != nil {
+		return a.IsPaidHS
+	}
+	return false
+}
+
+// GetIsPaidHF returns IsPaidHF
+func (a *Account) GetIsPaidHF() bool {
+	if a != nil {
+		return a.IsPaidHF
+	}
+	return false
+}
+
+// GetQuotas returns Quotas
+func (a *Account) GetQuotas() *Quotas {
+	if a != nil {
+		return a.Quotas
+	}
+	return nil
+}
+
+// GetRoleCode returns RoleCode
+func (a *Account) GetRoleCode() string {
+	if a != nil {
+		return a.RoleCode
+	}
+	return ""
+}
+
+// GetLocale returns Locale
+func (a *Account) GetLocale() string {
+	if a != nil {
+		return a.Locale
+	}
+	return ""
+}
+
+// GetTemplatesLeft returns TemplatesLeft
+func (q *Quotas) GetTemplatesLeft() int {
+	if q != nil {
+		return q.TemplatesLeft
+	}
+	return 0
+}
+
+// GetAPISignatureRequestsLeft returns APISignatureRequestsLeft
+func (q *Quotas) GetAPISignatureRequestsLeft() int {
+	if q != nil {
+		return q.APISignatureRequestsLeft
+	}
+	return 0
+}
+
+// GetDocumentsLeft returns DocumentsLeft
+func (q *Quotas) GetDocumentsLeft() int {
+	if q != nil {
+		return q.DocumentsLeft
+	}
+	return 0
+}
@@ -0,0 +1,22 @@
+package model
+
+type AccountResponse struct {
+	Account *Account   `json:"account"`
+	OAuth   *OAuthData `json:"oauth"` // Only present when creating an account with an app's client_id and client_secret.
+}
+
+// GetAccount returns Account
+func (a *AccountResponse) GetAccount() *Account {
+	if a != nil {
+		return a.Account
+	}
+	return nil
+}
+
+// GetOAuth returns OAuth
+func (a *AccountResponse) GetOAuth() *OAuthData {
+	if a != nil {
+		return a.OAuth
+	}
+	return nil
+}
@@ -1,17 +1,18 @@
 package model
 
 // APIApp contains information about an API App
-// Note: we ignore options and oauth here
+// Note: we ignore options here
 type APIApp struct {
-	ClientID             string   `json:"client_id"`
-	CreatedAt            int      `json:"created_at"`
-	Name                 string   `json:"name"`
-	Domain               string   `json:"domain"`
-	CallbackURL          string   `json:"callback_url"`
-	IsApproved           bool     `json:"is_approved"`
-	OwnerAccount         *Account `json:"owner_account"`
+	ClientID             string       `json:"client_id"`
+	CreatedAt            int          `json:"created_at"`
+	Name                 string       `json:"name"`
+	Domain               string       `json:"domain"`
+	CallbackURL          string       `json:"callback_url"`
+	IsApproved           bool         `json:"is_approved"`
+	OwnerAccount         *Account     `json:"owner_account"`
+	OAuth                *APIAppOAuth `json:"oauth"`
 	// WhiteLabelingOptions is an array of elements and values serialized to a string
-	WhiteLabelingOptions string   `json:"white_labeling_options"`
+	WhiteLabelingOptions string       `json:"white_labeling_options"`
 }
 
 // GetClientID returns ClientID
@@ -70,6 +71,14 @@ func (a *APIApp) GetOwnerAccount() *Account {
 	return nil
 }
 
+// GetOAuth returns OAuth
+func (a *APIApp) GetOAuth() *APIAppOAuth {
+	if a != nil {
+		return a.OAuth
+	}
+	return nil
+}
+
 // GetWhiteLabelingOptions returns WhiteLabelingOptions
 func (a *APIApp) GetWhiteLabelingOptions() string {
 	if a != nil {
@@ -0,0 +1,41 @@
+package model
+
+// APIAppOAuth contains the OAuth configuration of an API App
+type APIAppOAuth struct {
+	CallbackURL  string   `json:"callback_url"`  // The app's OAuth callback URL.
+	Secret       string   `json:"secret"`        // The app's OAuth secret, only returned to the app owner.
+	Scopes       []string `json:"scopes"`        // The scopes the app may request.
+	ChargesUsers bool     `json:"charges_users"` // Whether signature requests are billed to the app's users rather than the app owner.
+}
+
+// GetCallbackURL returns CallbackURL
+func (a *APIAppOAuth) GetCallbackURL() string {
+	if a != nil {
+		return a.CallbackURL
+	}
+	return ""
+}
+
+// GetSecret returns Secret
+func (a *APIAppOAuth) GetSecret() string {
+	if a != nil {
+		return a.Secret
+	}
+	return ""
+}
+
+// GetScopes returns Scopes
+func (a *APIAppOAuth) GetScopes() []string {
+	if a != nil {
+		return a.Scopes
+	}
+	return nil
+}
+
+// GetChargesUsers returns ChargesUsers
+func (a *APIAppOAuth) GetChargesUsers() bool {
+	if a != nil {
+		return a.ChargesUsers
+	}
+	return false
+}
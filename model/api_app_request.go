@@ -0,0 +1,68 @@
+package model
+
+// APIAppRequest contains the request parameters for creating or updating an API App
+type APIAppRequest struct {
+	Name                 string `form_field:"name"`
+	Domain               string `form_field:"domain"`
+	CallbackURL          string `form_field:"callback_url"`
+	CustomLogoFile       string `form_field:"custom_logo_file"` // Path to an image to show in the embedded and signing flows.
+	WhiteLabelingOptions string `form_field:"white_labeling_options"`
+	OAuthCallbackURL     string `form_field:"oauth[callback_url]"` // Required when OAuthScopes is set.
+	OAuthScopes          string `form_field:"oauth[scopes]"`       // A comma-separated list of OAuth scopes, e.g. "basic_account_info,request_signature".
+}
+
+// GetName returns Name
+func (a *APIAppRequest) GetName() string {
+	if a != nil {
+		return a.Name
+	}
+	return ""
+}
+
+// GetDomain returns Domain
+func (a *APIAppRequest) GetDomain() string {
+	if a != nil {
+		return a.Domain
+	}
+	return ""
+}
+
+// GetCallbackURL returns CallbackURL
+func (a *APIAppRequest) GetCallbackURL() string {
+	if a != nil {
+		return a.CallbackURL
+	}
+	return ""
+}
+
+// GetCustomLogoFile returns CustomLogoFile
+func (a *APIAppRequest) GetCustomLogoFile() string {
+	if a != nil {
+		return a.CustomLogoFile
+	}
+	return ""
+}
+
+// GetWhiteLabelingOptions returns WhiteLabelingOptions
+func (a *APIAppRequest) GetWhiteLabelingOptions() string {
+	if a != nil {
+		return a.WhiteLabelingOptions
+	}
+	return ""
+}
+
+// GetOAuthCallbackURL returns OAuthCallbackURL
+func (a *APIAppRequest) GetOAuthCallbackURL() string {
+	if a != nil {
+		return a.OAuthCallbackURL
+	}
+	return ""
+}
+
+// GetOAuthScopes returns OAuthScopes
+func (a *APIAppRequest) GetOAuthScopes() string {
+	if a != nil {
+		return a.OAuthScopes
+	}
+	return ""
+}
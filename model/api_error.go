@@ -0,0 +1,63 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// APIError is returned when HelloSign responds with a 4xx or 5xx status code
+type APIError struct {
+	StatusCode int       // The HTTP status code of the response.
+	ErrorName  string    // The error_name from the error envelope, eg: bad_request, conflict, exceeded_rate
+	ErrorMsg   string    // The error_msg from the error envelope.
+	Warnings   []Warning // Any warnings returned alongside (or instead of) the error.
+}
+
+// Error formats the error envelope, falling back to the warnings and then the status code
+func (e *APIError) Error() string {
+	if e.ErrorName != "" {
+		return fmt.Sprintf("%s: %s", e.ErrorName, e.ErrorMsg)
+	}
+
+	if len(e.Warnings) > 0 {
+		messages := []string{}
+		for _, w := range e.Warnings {
+			messages = append(messages, fmt.Sprintf("%s: %s", w.Name, w.Message))
+		}
+		return strings.Join(messages, ", ")
+	}
+
+	return fmt.Sprintf("hellosign request failed with status %d", e.StatusCode)
+}
+
+// GetStatusCode returns StatusCode
+func (e *APIError) GetStatusCode() int {
+	if e != nil {
+		return e.StatusCode
+	}
+	return 0
+}
+
+// GetErrorName returns ErrorName
+func (e *APIError) GetErrorName() string {
+	if e != nil {
+		return e.ErrorName
+	}
+	return ""
+}
+
+// GetErrorMsg returns ErrorMsg
+func (e *APIError) GetErrorMsg() string {
+	if e != nil {
+		return e.ErrorMsg
+	}
+	return ""
+}
+
+// GetWarnings returns Warnings
+func (e *APIError) GetWarnings() []Warning {
+	if e != nil {
+		return e.Warnings
+	}
+	return nil
+}
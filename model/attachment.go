@@ -0,0 +1,59 @@
+package model
+
+// Attachment is a file a signer is asked to upload, eg: a copy of their driver's license
+type Attachment struct {
+	ID           string `json:"id"`                                // The attachment identifier. Only returned by the API.
+	Name         string `json:"name" field:"name"`                 // The name of the attachment.
+	SignerIndex  int    `json:"signer_index" field:"signer_index"` // The 0-based index of the signer who uploads the attachment.
+	Instructions string `json:"instructions" field:"instructions"` // The instructions shown to the signer.
+	Required     bool   `json:"required" field:"required"`         // Whether the signer must upload the attachment before signing.
+	UploadedAt   int    `json:"uploaded_at"`                       // When the signer uploaded the attachment, or null. Only returned by the API.
+}
+
+// GetID returns ID
+func (a *Attachment) GetID() string {
+	if a != nil {
+		return a.ID
+	}
+	return ""
+}
+
+// GetName returns Name
+func (a *Attachment) GetName() string {
+	if a != nil {
+		return a.Name
+	}
+	return ""
+}
+
+// GetSignerIndex returns SignerIndex
+func (a *Attachment) GetSignerIndex() int {
+	if a != nil {
+		return a.SignerIndex
+	}
+	return 0
+}
+
+// GetInstructions returns Instructions
+func (a *Attachment) GetInstructions() string {
+	if a != nil {
+		return a.Instructions
+	}
+	return ""
+}
+
+// GetRequired returns Required
+func (a *Attachment) GetRequired() bool {
+	if a != nil {
+		return a.Required
+	}
+	return false
+}
+
+// GetUploadedAt returns UploadedAt
+func (a *Attachment) GetUploadedAt() int {
+	if a != nil {
+		return a.UploadedAt
+	}
+	return 0
+}
@@ -0,0 +1,61 @@
+package model
+
+// BulkSendJob contains information about a bulk send job
+type BulkSendJob struct {
+	BulkSendJobID string `json:"bulk_send_job_id"` // The id of the BulkSendJob.
+	Total         int    `json:"total"`            // The total amount of Signature Requests queued for sending.
+	IsCreator     bool   `json:"is_creator"`       // True if you are the owner of this BulkSendJob.
+	CreatedAt     int    `json:"created_at"`       // Time that the BulkSendJob was created.
+
+	// Only populated by GetBulkSendJob, from the page of signature requests returned alongside the job
+	ListInfo          *ListInfo           `json:"-"`
+	SignatureRequests []*SignatureRequest `json:"-"`
+}
+
+// GetBulkSendJobID returns BulkSendJobID
+func (b *BulkSendJob) GetBulkSendJobID() string {
+	if b != nil {
+		return b.BulkSendJobID
+	}
+	return ""
+}
+
+// GetTotal returns Total
+func (b *BulkSendJob) GetTotal() int {
+	if b != nil {
+		return b.Total
+	}
+	return 0
+}
+
+// GetIsCreator returns IsCreator
+func (b *BulkSendJob) GetIsCreator() bool {
+	if b != nil {
+		return b.IsCreator
+	}
+	return false
+}
+
+// GetCreatedAt returns CreatedAt
+func (b *BulkSendJob) GetCreatedAt() int {
+	if b != nil {
+		return b.CreatedAt
+	}
+	return 0
+}
+
+// GetListInfo returns ListInfo
+func (b *BulkSendJob) GetListInfo() *ListInfo {
+	if b != nil {
+		return b.ListInfo
+	}
+	return nil
+}
+
+// GetSignatureRequests returns SignatureRequests
+func (b *BulkSendJob) GetSignatureRequests() []*SignatureRequest {
+	if b != nil {
+		return b.SignatureRequests
+	}
+	return nil
+}
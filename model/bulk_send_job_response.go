@@ -0,0 +1,31 @@
+package model
+
+type BulkSendJobResponse struct {
+	BulkSendJob       *BulkSendJob        `json:"bulk_send_job"`
+	ListInfo          *ListInfo           `json:"list_info"`
+	SignatureRequests []*SignatureRequest `json:"signature_requests"`
+}
+
+// GetBulkSendJob returns BulkSendJob
+func (b *BulkSendJobResponse) GetBulkSendJob() *BulkSendJob {
+	if b != nil {
+		return b.BulkSendJob
+	}
+	return nil
+}
+
+// GetListInfo returns ListInfo
+func (b *BulkSendJobResponse) GetListInfo() *ListInfo {
+	if b != nil {
+		return b.ListInfo
+	}
+	return nil
+}
+
+// GetSignatureRequests returns SignatureRequests
+func (b *BulkSendJobResponse) GetSignatureRequests() []*SignatureRequest {
+	if b != nil {
+		return b.SignatureRequests
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package model
+
+// BulkSendWithTemplateRequest contains the request parameters for signature_request/bulk_send_with_template
+// Exactly one of SignerFile or SignerList must be provided.
+type BulkSendWithTemplateRequest struct {
+	TestMode              bool              `form_field:"test_mode"`
+	TemplateIDs           []string          `form_field:"template_ids"`
+	SignerFile            string            `form_field:"signer_file"` // Path to a CSV with one row of signers and custom fields per signature request.
+	SignerList            []BulkSignerList  `form_field:"signer_list"` // One entry per signature request.
+	Title                 string            `form_field:"title"`
+	Subject               string            `form_field:"subject"`
+	Message               string            `form_field:"message"`
+	SigningRedirectURL    string            `form_field:"signing_redirect_url"`
+	RequestingRedirectURL string            `form_field:"requesting_redirect_url"`
+	CCs                   []CCRole          `form_field:"ccs"`
+	Metadata              map[string]string `form_field:"metadata"`
+}
+
+// GetTestMode returns TestMode
+func (b *BulkSendWithTemplateRequest) GetTestMode() bool {
+	if b != nil {
+		return b.TestMode
+	}
+	return false
+}
+
+// GetTemplateIDs returns TemplateIDs
+func (b *BulkSendWithTemplateRequest) GetTemplateIDs() []string {
+	if b != nil {
+		return b.TemplateIDs
+	}
+	return nil
+}
+
+// GetSignerFile returns SignerFile
+func (b *BulkSendWithTemplateRequest) GetSignerFile() string {
+	if b != nil {
+		return b.SignerFile
+	}
+	return ""
+}
+
+// GetSignerList returns SignerList
+func (b *BulkSendWithTemplateRequest) GetSignerList() []BulkSignerList {
+	if b != nil {
+		return b.SignerList
+	}
+	return nil
+}
+
+// GetTitle returns Title
+func (b *BulkSendWithTemplateRequest) GetTitle() string {
+	if b != nil {
+		return b.Title
+	}
+	return ""
+}
+
+// GetSubject returns Subject
+func (b *BulkSendWithTemplateRequest) GetSubject() string {
+	if b != nil {
+		return b.Subject
+	}
+	return ""
+}
+
+// GetMessage returns Message
+func (b *BulkSendWithTemplateRequest) GetMessage() string {
+	if b != nil {
+		return b.Message
+	}
+	return ""
+}
+
+// GetSigningRedirectURL returns SigningRedirectURL
+func (b *BulkSendWithTemplateRequest) GetSigningRedirectURL() string {
+	if b != nil {
+		return b.SigningRedirectURL
+	}
+	return ""
+}
+
+// GetRequestingRedirectURL returns RequestingRedirectURL
+func (b *BulkSendWithTemplateRequest) GetRequestingRedirectURL() string {
+	if b != nil {
+		return b.RequestingRedirectURL
+	}
+	return ""
+}
+
+// GetCCs returns CCs
+func (b *BulkSendWithTemplateRequest) GetCCs() []CCRole {
+	if b != nil {
+		return b.CCs
+	}
+	return nil
+}
+
+// GetMetadata returns Metadata
+func (b *BulkSendWithTemplateRequest) GetMetadata() map[string]string {
+	if b != nil {
+		return b.Metadata
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package model
+
+// BulkSignerList contains the signers and custom field values of one signature request in a bulk send
+type BulkSignerList struct {
+	Signers      map[string]BulkSigner `json:"signers"`                 // Signers keyed by template role name.
+	CustomFields map[string]string     `json:"custom_fields,omitempty"` // Custom field values keyed by field name.
+}
+
+// BulkSigner is a signer in a BulkSignerList
+type BulkSigner struct {
+	Name  string `json:"name"`
+	Email string `json:"email_address"`
+	Pin   string `json:"pin,omitempty"`
+}
+
+// GetSigners returns Signers
+func (b *BulkSignerList) GetSigners() map[string]BulkSigner {
+	if b != nil {
+		return b.Signers
+	}
+	return nil
+}
+
+// GetCustomFields returns CustomFields
+func (b *BulkSignerList) GetCustomFields() map[string]string {
+	if b != nil {
+		return b.CustomFields
+	}
+	return nil
+}
+
+// GetName returns Name
+func (b *BulkSigner) GetName() string {
+	if b != nil {
+		return b.Name
+	}
+	return ""
+}
+
+// GetEmail returns Email
+func (b *BulkSigner) GetEmail() string {
+	if b != nil {
+		return b.Email
+	}
+	return ""
+}
+
+// GetPin returns Pin
+func (b *BulkSigner) GetPin() string {
+	if b != nil {
+		return b.Pin
+	}
+	return ""
+}
@@ -0,0 +1,23 @@
+package model
+
+// CCRole assigns an email address to one of the CC roles defined on a template
+type CCRole struct {
+	Name  string `field:"name"`
+	Email string `field:"email_address"`
+}
+
+// GetName returns the Name of the CC role
+func (c *CCRole) GetName() string {
+	if c != nil {
+		return c.Name
+	}
+	return ""
+}
+
+// GetEmail returns the Email assigned to the CC role
+func (c *CCRole) GetEmail() string {
+	if c != nil {
+		return c.Email
+	}
+	return ""
+}
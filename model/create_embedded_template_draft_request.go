@@ -0,0 +1,149 @@
+package model
+
+// CreateEmbeddedTemplateDraftRequest contains the request parameters for template/create_embedded_draft, including the options that control the editor
+type CreateEmbeddedTemplateDraftRequest struct {
+	TestMode          bool              `form_field:"test_mode"`
+	ClientID          string            `form_field:"client_id"`
+	File              []string          `form_field:"file"`
+	FileUploads       []FileUpload      `form_field:"file"`
+	FileURL           []string          `form_field:"file_url"`
+	Title             string            `form_field:"title"`
+	Subject           string            `form_field:"subject"`
+	Message           string            `form_field:"message"`
+	SignerRoles       []SignerRole      `form_field:"signer_roles"`
+	CCRoles           []string          `form_field:"cc_roles"`
+	MergeFields       []MergeField      `form_field:"merge_fields"` // Fields the sender fills in when using the template. Sent as JSON.
+	Metadata          map[string]string `form_field:"metadata"`
+	ShowPreview       bool              `form_field:"show_preview"`
+	SkipMeNow         bool              `form_field:"skip_me_now"`         // Hides the "Me (Now)" signer option in the editor.
+	AllowReassign     bool              `form_field:"allow_reassign"`      // Lets signers reassign their signature requests to other signers.
+	CanReorderSigners bool              `form_field:"can_reorder_signers"` // Lets the editor change the signer order.
+}
+
+// GetTestMode returns TestMode
+func (c *CreateEmbeddedTemplateDraftRequest) GetTestMode() bool {
+	if c != nil {
+		return c.TestMode
+	}
+	return false
+}
+
+// GetClientID returns ClientID
+func (c *CreateEmbeddedTemplateDraftRequest) GetClientID() string {
+	if c != nil {
+		return c.ClientID
+	}
+	return ""
+}
+
+// GetFile returns File
+func (c *CreateEmbeddedTemplateDraftRequest) GetFile() []string {
+	if c != nil {
+		return c.File
+	}
+	return nil
+}
+
+// GetFileUploads returns FileUploads
+func (c *CreateEmbeddedTemplateDraftRequest) GetFileUploads() []FileUpload {
+	if c != nil {
+		return c.FileUploads
+	}
+	return nil
+}
+
+// GetFileURL returns FileURL
+func (c *CreateEmbeddedTemplateDraftRequest) GetFileURL() []string {
+	if c != nil {
+		return c.FileURL
+	}
+	return nil
+}
+
+// GetTitle returns Title
+func (c *CreateEmbeddedTemplateDraftRequest) GetTitle() string {
+	if c != nil {
+		return c.Title
+	}
+	return ""
+}
+
+// GetSubject returns Subject
+func (c *CreateEmbeddedTemplateDraftRequest) GetSubject() string {
+	if c != nil {
+		return c.Subject
+	}
+	return ""
+}
+
+// GetMessage returns Message
+func (c *CreateEmbeddedTemplateDraftRequest) GetMessage() string {
+	if c != nil {
+		return c.Message
+	}
+	return ""
+}
+
+// GetSignerRoles returns SignerRoles
+func (c *CreateEmbeddedTemplateDraftRequest) GetSignerRoles() []SignerRole {
+	if c != nil {
+		return c.SignerRoles
+	}
+	return nil
+}
+
+// GetCCRoles returns CCRoles
+func (c *CreateEmbeddedTemplateDraftRequest) GetCCRoles() []string {
+	if c != nil {
+		return c.CCRoles
+	}
+	return nil
+}
+
+// GetMergeFields returns MergeFields
+func (c *CreateEmbeddedTemplateDraftRequest) GetMergeFields() []MergeField {
+	if c != nil {
+		return c.MergeFields
+	}
+	return nil
+}
+
+// GetMetadata returns Metadata
+func (c *CreateEmbeddedTemplateDraftRequest) GetMetadata() map[string]string {
+	if c != nil {
+		return c.Metadata
+	}
+	return nil
+}
+
+// GetShowPreview returns ShowPreview
+func (c *CreateEmbeddedTemplateDraftRequest) GetShowPreview() bool {
+	if c != nil {
+		return c.ShowPreview
+	}
+	return false
+}
+
+// GetSkipMeNow returns SkipMeNow
+func (c *CreateEmbeddedTemplateDraftRequest) GetSkipMeNow() bool {
+	if c != nil {
+		return c.SkipMeNow
+	}
+	return false
+}
+
+// GetAllowReassign returns AllowReassign
+func (c *CreateEmbeddedTemplateDraftRequest) GetAllowReassign() bool {
+	if c != nil {
+		return c.AllowReassign
+	}
+	return false
+}
+
+// GetCanReorderSigners returns CanReorderSigners
+func (c *CreateEmbeddedTemplateDraftRequest) GetCanReorderSigners() bool {
+	if c != nil {
+		return c.CanReorderSigners
+	}
+	return false
+}
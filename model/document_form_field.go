@@ -1,5 +1,19 @@
 package model
 
+import (
+	"errors"
+	"fmt"
+)
+
+// Form field types accepted in form_fields_per_document
+const (
+	FieldTypeText       = "text"
+	FieldTypeCheckbox   = "checkbox"
+	FieldTypeSignature  = "signature"
+	FieldTypeDateSigned = "date_signed"
+	FieldTypeInitials   = "initials"
+)
+
 type DocumentFormField struct {
 	APIId    string `json:"api_id"`
 	Name     string `json:"name"`
@@ -10,6 +24,63 @@ type DocumentFormField struct {
 	Height   int    `json:"height"`
 	Required bool   `json:"required"`
 	Signer   int    `json:"signer"`
+	Page     int    `json:"page,omitempty"` // The 1-based page the field is placed on. Omitted when 0.
+}
+
+// NewFormField builds a form field of fieldType, signer is the 0-based index of the signer who fills it in
+func NewFormField(fieldType, apiID string, x, y, width, height, page, signer int) (DocumentFormField, error) {
+	switch fieldType {
+	case FieldTypeText, FieldTypeCheckbox, FieldTypeSignature, FieldTypeDateSigned, FieldTypeInitials:
+	default:
+		return DocumentFormField{}, fmt.Errorf("unknown form field type %q", fieldType)
+	}
+	if apiID == "" {
+		return DocumentFormField{}, errors.New("form field api_id is required")
+	}
+	if x < 0 || y < 0 {
+		return DocumentFormField{}, fmt.Errorf("form field %s must have a non-negative position, got x=%d y=%d", apiID, x, y)
+	}
+	if width <= 0 || height <= 0 {
+		return DocumentFormField{}, fmt.Errorf("form field %s must have a positive size, got width=%d height=%d", apiID, width, height)
+	}
+	if page < 1 {
+		return DocumentFormField{}, fmt.Errorf("form field %s has an invalid page: %d", apiID, page)
+	}
+	if signer < 0 {
+		return DocumentFormField{}, fmt.Errorf("form field %s has a negative signer: %d", apiID, signer)
+	}
+
+	return DocumentFormField{
+		APIId:    apiID,
+		Type:     fieldType,
+		X:        x,
+		Y:        y,
+		Width:    width,
+		Height:   height,
+		Page:     page,
+		Signer:   signer,
+		Required: fieldType == FieldTypeSignature,
+	}, nil
+}
+
+// NewTextField builds an optional text field
+func NewTextField(apiID string, x, y, width, height, page, signer int) (DocumentFormField, error) {
+	return NewFormField(FieldTypeText, apiID, x, y, width, height, page, signer)
+}
+
+// NewSignatureField builds a required signature field
+func NewSignatureField(apiID string, x, y, width, height, page, signer int) (DocumentFormField, error) {
+	return NewFormField(FieldTypeSignature, apiID, x, y, width, height, page, signer)
+}
+
+// NewCheckboxField builds an optional checkbox field
+func NewCheckboxField(apiID string, x, y, width, height, page, signer int) (DocumentFormField, error) {
+	return NewFormField(FieldTypeCheckbox, apiID, x, y, width, height, page, signer)
+}
+
+// NewDateField builds a date_signed field, which HelloSign fills in with the date the signer signs
+func NewDateField(apiID string, x, y, width, height, page, signer int) (DocumentFormField, error) {
+	return NewFormField(FieldTypeDateSigned, apiID, x, y, width, height, page, signer)
 }
 
 // GetAPIId returns APIId
@@ -83,3 +154,11 @@ func (d *DocumentFormField) GetSigner() int {
 	}
 	return 0
 }
+
+// GetPage returns Page
+func (d *DocumentFormField) GetPage() int {
+	if d != nil {
+		return d.Page
+	}
+	return 0
+}
@@ -1,18 +1,22 @@
 package model
 
 // Template contains information about the templates
-// Note: we are leaving out cc_roles, accounts
 type Template struct {
-	TemplateID  string            `json:"template_id"`  // A Template unique identifier.
-	Title       string            `json:"title"`        // The title of the template.
-	Message     string            `json:"message"`      // The default message that will be sent to signers
-	Metadata    map[string]string `json:"metadata"`     // The metadata attached to the template.
-	SignerRoles []SignerRole      `json:"signer_roles"` // The current status of the signature. eg: awaiting_signature, signed, declined
-	Documents   []Document        `json:"documents"`    // A collection of document that is associated with this template
-	IsCreator   bool              `json:"is_creator"`
-	IsEmbedded  bool              `json:"is_embedded"`  // True if the template was created using an embedded flow
-	CanEdit     bool              `json:"can_edit"`
-	IsLocked    bool              `json:"is_locked"`
+	TemplateID   string            `json:"template_id"`   // A Template unique identifier.
+	Title        string            `json:"title"`         // The title of the template.
+	Message      string            `json:"message"`       // The default message that will be sent to signers
+	Metadata     map[string]string `json:"metadata"`      // The metadata attached to the template.
+	SignerRoles  []SignerRole      `json:"signer_roles"`  // The current status of the signature. eg: awaiting_signature, signed, declined
+	CCRoles      []CCRole          `json:"cc_roles"`      // An array of the designated CC roles that must be specified when sending a SignatureRequest using this Template.
+	CustomFields []CustomField     `json:"custom_fields"` // An array of Custom Field objects containing the name and type of each custom field.
+	Documents    []Document        `json:"documents"`     // A collection of document that is associated with this template
+	Accounts     []Account         `json:"accounts"`      // An array of the Accounts that can use this Template.
+	IsCreator    bool              `json:"is_creator"`
+	IsEmbedded   bool              `json:"is_embedded"` // True if the template was created using an embedded flow
+	CanEdit      bool              `json:"can_edit"`
+	IsLocked     bool              `json:"is_locked"`
+	EditURL      string            `json:"edit_url"`   // Only returned after the template files are updated.
+	ExpiresAt    int               `json:"expires_at"` // When the edit_url expires.
 }
 
 // GetTemplateID returns TemplateID
@@ -55,6 +59,22 @@ func (t *Template) GetSignerRoles() []SignerRole {
 	return nil
 }
 
+// GetCCRoles returns CCRoles
+func (t *Template) GetCCRoles() []CCRole {
+	if t != nil {
+		return t.CCRoles
+	}
+	return nil
+}
+
+// GetCustomFields returns CustomFields
+func (t *Template) GetCustomFields() []CustomField {
+	if t != nil {
+		return t.CustomFields
+	}
+	return nil
+}
+
 // GetDocuments returns Documents
 func (t *Template) GetDocuments() []Document {
 	if t != nil {
@@ -63,6 +83,14 @@ func (t *Template) GetDocuments() []Document {
 	return nil
 }
 
+// GetAccounts returns Accounts
+func (t *Template) GetAccounts() []Account {
+	if t != nil {
+		return t.Accounts
+	}
+	return nil
+}
+
 // GetIsCreator returns IsCreator
 func (t *Template) GetIsCreator() bool {
 	if t != nil {
@@ -94,3 +122,19 @@ func (t *Template) GetIsLocked() bool {
 	}
 	return false
 }
+
+// GetEditURL returns EditURL
+func (t *Template) GetEditURL() string {
+	if t != nil {
+		return t.EditURL
+	}
+	return ""
+}
+
+// GetExpiresAt returns ExpiresAt
+func (t *Template) GetExpiresAt() int {
+	if t != nil {
+		return t.ExpiresAt
+	}
+	return 0
+}
@@ -0,0 +1,7 @@
+package model
+
+// Downloadable is a JSON response the files endpoints return in place of the files themselves,
+// eg: FileURLResponse or FileDataURIResponse
+type Downloadable interface {
+	DownloadFormField() string // The form field set to true to ask for this response instead of the files.
+}
@@ -2,21 +2,29 @@ package model
 
 // EmbeddedSignatureRequest contains the request parameters for create_embedded
 type EmbeddedSignatureRequest struct {
-	TestMode              bool                  `form_field:"test_mode"`
-	ClientID              string                `form_field:"client_id"`
-	FileURL               []string              `form_field:"file_url"`
-	File                  []string              `form_field:"file"`
-	Title                 string                `form_field:"title"`
-	Subject               string                `form_field:"subject"`
-	Message               string                `form_field:"message"`
-	SigningRedirectURL    string                `form_field:"signing_redirect_url"`
-	Signers               []Signer              `form_field:"signers"`
-	CustomFields          []CustomField         `form_field:"custom_fields"`
-	CCEmailAddresses      []string              `form_field:"cc_email_addresses"`
-	UseTextTags           bool                  `form_field:"use_text_tags"`
-	HideTextTags          bool                  `form_field:"hide_text_tags"`
-	Metadata              map[string]string     `form_field:"metadata"`
-	FormFieldsPerDocument [][]DocumentFormField `form_field:"form_fields_per_document"`
+	TestMode               bool                  `form_field:"test_mode"`
+	ClientID               string                `form_field:"client_id"`
+	FileURL                []string              `form_field:"file_url"`
+	File                   []string              `form_field:"file"`
+	FileUploads            []FileUpload          `form_field:"file"`
+	Title                  string                `form_field:"title"`
+	Subject                string                `form_field:"subject"`
+	Message                string                `form_field:"message"`
+	SigningRedirectURL     string                `form_field:"signing_redirect_url"`
+	Signers                []Signer              `form_field:"signers"`
+	CustomFields           []CustomField         `form_field:"custom_fields"`
+	CCEmailAddresses       []string              `form_field:"cc_email_addresses"`
+	UseTextTags            bool                  `form_field:"use_text_tags"`
+	HideTextTags           bool                  `form_field:"hide_text_tags"`
+	AllowDecline           bool                  `form_field:"allow_decline"`
+	AllowReassign          bool                  `form_field:"allow_reassign,omitfalse"`  // Lets signers reassign the request to someone else.
+	PopulateAutoFillFields bool                  `form_field:"populate_auto_fill_fields"` // Fills in signer details such as name and date for fields HelloSign can auto-fill.
+	SigningOptions         *SigningOptions       `form_field:"signing_options"`
+	FieldOptions           *FieldOptions         `form_field:"field_options"`
+	Attachments            []Attachment          `form_field:"attachments"`
+	ExpiresAt              int64                 `form_field:"expires_at"` // Unix time after which the request can no longer be signed. Omitted when 0.
+	Metadata               map[string]string     `form_field:"metadata"`
+	FormFieldsPerDocument  [][]DocumentFormField `form_field:"form_fields_per_document"`
 }
 
 // GetTestMode returns TestMode
@@ -51,6 +59,14 @@ func (e *EmbeddedSignatureRequest) GetFile() []string {
 	return nil
 }
 
+// GetFileUploads returns FileUploads
+func (e *EmbeddedSignatureRequest) GetFileUploads() []FileUpload {
+	if e != nil {
+		return e.FileUploads
+	}
+	return nil
+}
+
 // GetTitle returns Title
 func (e *EmbeddedSignatureRequest) GetTitle() string {
 	if e != nil {
@@ -123,6 +139,62 @@ func (e *EmbeddedSignatureRequest) GetHideTextTags() bool {
 	return false
 }
 
+// GetAllowDecline returns AllowDecline
+func (e *EmbeddedSignatureRequest) GetAllowDecline() bool {
+	if e != nil {
+		return e.AllowDecline
+	}
+	return false
+}
+
+// GetAllowReassign returns AllowReassign
+func (e *EmbeddedSignatureRequest) GetAllowReassign() bool {
+	if e != nil {
+		return e.AllowReassign
+	}
+	return false
+}
+
+// GetPopulateAutoFillFields returns PopulateAutoFillFields
+func (e *EmbeddedSignatureRequest) GetPopulateAutoFillFields() bool {
+	if e != nil {
+		return e.PopulateAutoFillFields
+	}
+	return false
+}
+
+// GetSigningOptions returns SigningOptions
+func (e *EmbeddedSignatureRequest) GetSigningOptions() *SigningOptions {
+	if e != nil {
+		return e.SigningOptions
+	}
+	return nil
+}
+
+// GetFieldOptions returns FieldOptions
+func (e *EmbeddedSignatureRequest) GetFieldOptions() *FieldOptions {
+	if e != nil {
+		return e.FieldOptions
+	}
+	return nil
+}
+
+// GetAttachments returns Attachments
+func (e *EmbeddedSignatureRequest) GetAttachments() []Attachment {
+	if e != nil {
+		return e.Attachments
+	}
+	return nil
+}
+
+// GetExpiresAt returns ExpiresAt
+func (e *EmbeddedSignatureRequest) GetExpiresAt() int64 {
+	if e != nil {
+		return e.ExpiresAt
+	}
+	return 0
+}
+
 // GetMetadata returns Metadata
 func (e *EmbeddedSignatureRequest) GetMetadata() map[string]string {
 	if e != nil {
@@ -137,4 +209,4 @@ func (e *EmbeddedSignatureRequest) GetFormFieldsPerDocument() [][]DocumentFormFi
 		return e.FormFieldsPerDocument
 	}
 	return nil
-}
\ No newline at end of file
+}
@@ -2,16 +2,24 @@ package model
 
 // EmbeddedSignatureWithTemplateRequest contains the request parameters for create_embedded
 type EmbeddedSignatureWithTemplateRequest struct {
-	TestMode         bool              `form_field:"test_mode"`
-	ClientID         string            `form_field:"client_id"`
-	Title            string            `form_field:"title"`
-	Subject          string            `form_field:"subject"`
-	Message          string            `form_field:"message"`
-	Signers          []Signer          `form_field:"signers"`
-	CustomFields     []CustomField     `form_field:"custom_fields"`
-	CCEmailAddresses []string          `form_field:"cc_email_addresses"`
-	Metadata         map[string]string `form_field:"metadata"`
-	TemplateID       string            `form_field:"template_id"`
+	TestMode              bool                  `form_field:"test_mode"`
+	ClientID              string                `form_field:"client_id"`
+	Title                 string                `form_field:"title"`
+	Subject               string                `form_field:"subject"`
+	Message               string                `form_field:"message"`
+	SigningRedirectURL    string                `form_field:"signing_redirect_url"`
+	Signers               []Signer              `form_field:"signers"`
+	CustomFields          []CustomField         `form_field:"custom_fields"`
+	FormFieldsPerDocument [][]DocumentFormField `form_field:"form_fields_per_document"` // Overrides properties of the template's fields, matched by api_id.
+	CCEmailAddresses      []string              `form_field:"cc_email_addresses"`
+	CCs                   []CCRole              `form_field:"ccs"` // CCs for the template's named CC roles, sent as ccs[role][email_address].
+	AllowDecline          bool                  `form_field:"allow_decline"`
+	AllowReassign         bool                  `form_field:"allow_reassign,omitfalse"` // Lets signers reassign the request to someone else.
+	FieldOptions          *FieldOptions         `form_field:"field_options"`
+	Attachments           []Attachment          `form_field:"attachments"`
+	ExpiresAt             int64                 `form_field:"expires_at"` // Unix time after which the request can no longer be signed. Omitted when 0.
+	Metadata              map[string]string     `form_field:"metadata"`
+	TemplateID            string                `form_field:"template_id"`
 }
 
 // GetTestMode returns TestMode
@@ -54,6 +62,14 @@ func (e *EmbeddedSignatureWithTemplateRequest) GetMessage() string {
 	return ""
 }
 
+// GetSigningRedirectURL returns SigningRedirectURL
+func (e *EmbeddedSignatureWithTemplateRequest) GetSigningRedirectURL() string {
+	if e != nil {
+		return e.SigningRedirectURL
+	}
+	return ""
+}
+
 // GetSigners returns Signers
 func (e *EmbeddedSignatureWithTemplateRequest) GetSigners() []Signer {
 	if e != nil {
@@ -70,6 +86,14 @@ func (e *EmbeddedSignatureWithTemplateRequest) GetCustomFields() []CustomField {
 	return nil
 }
 
+// GetFormFieldsPerDocument returns FormFieldsPerDocument
+func (e *EmbeddedSignatureWithTemplateRequest) GetFormFieldsPerDocument() [][]DocumentFormField {
+	if e != nil {
+		return e.FormFieldsPerDocument
+	}
+	return nil
+}
+
 // GetCCEmailAddresses returns CCEmailAddresses
 func (e *EmbeddedSignatureWithTemplateRequest) GetCCEmailAddresses() []string {
 	if e != nil {
@@ -78,6 +102,54 @@ func (e *EmbeddedSignatureWithTemplateRequest) GetCCEmailAddresses() []string {
 	return nil
 }
 
+// GetCCs returns CCs
+func (e *EmbeddedSignatureWithTemplateRequest) GetCCs() []CCRole {
+	if e != nil {
+		return e.CCs
+	}
+	return nil
+}
+
+// GetAllowDecline returns AllowDecline
+func (e *EmbeddedSignatureWithTemplateRequest) GetAllowDecline() bool {
+	if e != nil {
+		return e.AllowDecline
+	}
+	return false
+}
+
+// GetAllowReassign returns AllowReassign
+func (e *EmbeddedSignatureWithTemplateRequest) GetAllowReassign() bool {
+	if e != nil {
+		return e.AllowReassign
+	}
+	return false
+}
+
+// GetFieldOptions returns FieldOptions
+func (e *EmbeddedSignatureWithTemplateRequest) GetFieldOptions() *FieldOptions {
+	if e != nil {
+		return e.FieldOptions
+	}
+	return nil
+}
+
+// GetAttachments returns Attachments
+func (e *EmbeddedSignatureWithTemplateRequest) GetAttachments() []Attachment {
+	if e != nil {
+		return e.Attachments
+	}
+	return nil
+}
+
+// GetExpiresAt returns ExpiresAt
+func (e *EmbeddedSignatureWithTemplateRequest) GetExpiresAt() int64 {
+	if e != nil {
+		return e.ExpiresAt
+	}
+	return 0
+}
+
 // GetMetadata returns Metadata
 func (e *EmbeddedSignatureWithTemplateRequest) GetMetadata() map[string]string {
 	if e != nil {
@@ -0,0 +1,167 @@
+package model
+
+// EmbeddedUnclaimedDraftRequest contains the request parameters for unclaimed_draft/create_embedded
+type EmbeddedUnclaimedDraftRequest struct {
+	TestMode              bool                  `form_field:"test_mode"`
+	ClientID              string                `form_field:"client_id"`
+	RequesterEmailAddress string                `form_field:"requester_email_address"`
+	Type                  string                `form_field:"type"`
+	FileURL               []string              `form_field:"file_url"`
+	File                  []string              `form_field:"file"`
+	FileUploads           []FileUpload          `form_field:"file"`
+	Subject               string                `form_field:"subject"`
+	Message               string                `form_field:"message"`
+	SigningRedirectURL    string                `form_field:"signing_redirect_url"`
+	RequestingRedirectURL string                `form_field:"requesting_redirect_url"`
+	IsForEmbeddedSigning  bool                  `form_field:"is_for_embedded_signing"`
+	Signers               []Signer              `form_field:"signers"`
+	CCEmailAddresses      []string              `form_field:"cc_email_addresses"`
+	UseTextTags           bool                  `form_field:"use_text_tags"`
+	HideTextTags          bool                  `form_field:"hide_text_tags"`
+	Metadata              map[string]string     `form_field:"metadata"`
+	FormFieldsPerDocument [][]DocumentFormField `form_field:"form_fields_per_document"`
+}
+
+// GetTestMode returns TestMode
+func (u *EmbeddedUnclaimedDraftRequest) GetTestMode() bool {
+	if u != nil {
+		return u.TestMode
+	}
+	return false
+}
+
+// GetClientID returns ClientID
+func (u *EmbeddedUnclaimedDraftRequest) GetClientID() string {
+	if u != nil {
+		return u.ClientID
+	}
+	return ""
+}
+
+// GetRequesterEmailAddress returns RequesterEmailAddress
+func (u *EmbeddedUnclaimedDraftRequest) GetRequesterEmailAddress() string {
+	if u != nil {
+		return u.RequesterEmailAddress
+	}
+	return ""
+}
+
+// GetType returns Type
+func (u *EmbeddedUnclaimedDraftRequest) GetType() string {
+	if u != nil {
+		return u.Type
+	}
+	return ""
+}
+
+// GetFileURL returns FileURL
+func (u *EmbeddedUnclaimedDraftRequest) GetFileURL() []string {
+	if u != nil {
+		return u.FileURL
+	}
+	return nil
+}
+
+// GetFile returns File
+func (u *EmbeddedUnclaimedDraftRequest) GetFile() []string {
+	if u != nil {
+		return u.File
+	}
+	return nil
+}
+
+// GetFileUploads returns FileUploads
+func (u *EmbeddedUnclaimedDraftRequest) GetFileUploads() []FileUpload {
+	if u != nil {
+		return u.FileUploads
+	}
+	return nil
+}
+
+// GetSubject returns Subject
+func (u *EmbeddedUnclaimedDraftRequest) GetSubject() string {
+	if u != nil {
+		return u.Subject
+	}
+	return ""
+}
+
+// GetMessage returns Message
+func (u *EmbeddedUnclaimedDraftRequest) GetMessage() string {
+	if u != nil {
+		return u.Message
+	}
+	return ""
+}
+
+// GetSigningRedirectURL returns SigningRedirectURL
+func (u *EmbeddedUnclaimedDraftRequest) GetSigningRedirectURL() string {
+	if u != nil {
+		return u.SigningRedirectURL
+	}
+	return ""
+}
+
+// GetRequestingRedirectURL returns RequestingRedirectURL
+func (u *EmbeddedUnclaimedDraftRequest) GetRequestingRedirectURL() string {
+	if u != nil {
+		return u.RequestingRedirectURL
+	}
+	return ""
+}
+
+// GetIsForEmbeddedSigning returns IsForEmbeddedSigning
+func (u *EmbeddedUnclaimedDraftRequest) GetIsForEmbeddedSigning() bool {
+	if u != nil {
+		return u.IsForEmbeddedSigning
+	}
+	return false
+}
+
+// GetSigners returns Signers
+func (u *EmbeddedUnclaimedDraftRequest) GetSigners() []Signer {
+	if u != nil {
+		return u.Signers
+	}
+	return nil
+}
+
+// GetCCEmailAddresses returns CCEmailAddresses
+func (u *EmbeddedUnclaimedDraftRequest) GetCCEmailAddresses() []string {
+	if u != nil {
+		return u.CCEmailAddresses
+	}
+	return nil
+}
+
+// GetUseTextTags returns UseTextTags
+func (u *EmbeddedUnclaimedDraftRequest) GetUseTextTags() bool {
+	if u != nil {
+		return u.UseTextTags
+	}
+	return false
+}
+
+// GetHideTextTags returns HideTextTags
+func (u *EmbeddedUnclaimedDraftRequest) GetHideTextTags() bool {
+	if u != nil {
+		return u.HideTextTags
+	}
+	return false
+}
+
+// GetMetadata returns Metadata
+func (u *EmbeddedUnclaimedDraftRequest) GetMetadata() map[string]string {
+	if u != nil {
+		return u.Metadata
+	}
+	return nil
+}
+
+// GetFormFieldsPerDocument returns FormFieldsPerDocument
+func (u *EmbeddedUnclaimedDraftRequest) GetFormFieldsPerDocument() [][]DocumentFormField {
+	if u != nil {
+		return u.FormFieldsPerDocument
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package model
+
+// EmbeddedUnclaimedDraftWithTemplateRequest contains the request parameters for unclaimed_draft/create_embedded_with_template
+// CustomFields pre-fills the template's custom fields, keyed by custom field name
+type EmbeddedUnclaimedDraftWithTemplateRequest struct {
+	TestMode              bool              `form_field:"test_mode"`
+	ClientID              string            `form_field:"client_id"`
+	RequesterEmailAddress string            `form_field:"requester_email_address"`
+	TemplateIDs           []string          `form_field:"template_ids"`
+	Title                 string            `form_field:"title"`
+	Subject               string            `form_field:"subject"`
+	Message               string            `form_field:"message"`
+	SigningRedirectURL    string            `form_field:"signing_redirect_url"`
+	RequestingRedirectURL string            `form_field:"requesting_redirect_url"`
+	IsForEmbeddedSigning  bool              `form_field:"is_for_embedded_signing"`
+	Signers               []Signer          `form_field:"signers"`
+	CCs                   []CCRole          `form_field:"ccs"`
+	CustomFields          map[string]string `form_field:"custom_fields"`
+	Metadata              map[string]string `form_field:"metadata"`
+}
+
+// GetTestMode returns TestMode
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetTestMode() bool {
+	if u != nil {
+		return u.TestMode
+	}
+	return false
+}
+
+// GetClientID returns ClientID
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetClientID() string {
+	if u != nil {
+		return u.ClientID
+	}
+	return ""
+}
+
+// GetRequesterEmailAddress returns RequesterEmailAddress
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetRequesterEmailAddress() string {
+	if u != nil {
+		return u.RequesterEmailAddress
+	}
+	return ""
+}
+
+// GetTemplateIDs returns TemplateIDs
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetTemplateIDs() []string {
+	if u != nil {
+		return u.TemplateIDs
+	}
+	return nil
+}
+
+// GetTitle returns Title
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetTitle() string {
+	if u != nil {
+		return u.Title
+	}
+	return ""
+}
+
+// GetSubject returns Subject
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetSubject() string {
+	if u != nil {
+		return u.Subject
+	}
+	return ""
+}
+
+// GetMessage returns Message
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetMessage() string {
+	if u != nil {
+		return u.Message
+	}
+	return ""
+}
+
+// GetSigningRedirectURL returns SigningRedirectURL
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetSigningRedirectURL() string {
+	if u != nil {
+		return u.SigningRedirectURL
+	}
+	return ""
+}
+
+// GetRequestingRedirectURL returns RequestingRedirectURL
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetRequestingRedirectURL() string {
+	if u != nil {
+		return u.RequestingRedirectURL
+	}
+	return ""
+}
+
+// GetIsForEmbeddedSigning returns IsForEmbeddedSigning
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetIsForEmbeddedSigning() bool {
+	if u != nil {
+		return u.IsForEmbeddedSigning
+	}
+	return false
+}
+
+// GetSigners returns Signers
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetSigners() []Signer {
+	if u != nil {
+		return u.Signers
+	}
+	return nil
+}
+
+// GetCCs returns CCs
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetCCs() []CCRole {
+	if u != nil {
+		return u.CCs
+	}
+	return nil
+}
+
+// GetCustomFields returns CustomFields
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetCustomFields() map[string]string {
+	if u != nil {
+		return u.CustomFields
+	}
+	return nil
+}
+
+// GetMetadata returns Metadata
+func (u *EmbeddedUnclaimedDraftWithTemplateRequest) GetMetadata() map[string]string {
+	if u != nil {
+		return u.Metadata
+	}
+	return nil
+}
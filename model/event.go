@@ -0,0 +1,93 @@
+package model
+
+// EventCallback is the payload HelloSign posts, under the json form field, to account and app callback URLs
+type EventCallback struct {
+	Event            *Event            `json:"event"`
+	SignatureRequest *SignatureRequest `json:"signature_request"` // Included with signature request events.
+	Template         *Template         `json:"template"`          // Included with template events.
+}
+
+// Event contains information about an event HelloSign reported through a callback
+type Event struct {
+	EventTime string `json:"event_time"` // Time the event occurred, as a unix timestamp string.
+	EventType string `json:"event_type"` // The type of event, eg: signature_request_sent, signature_request_signed
+	EventHash string `json:"event_hash"` // HMAC-SHA256 of event_time and event_type, keyed by the account's API key.
+
+	EventMetadata *EventMetadata `json:"event_metadata"` // What the event refers to.
+
+	// Copied from the EventCallback by ParseEvent, when HelloSign included them
+	SignatureRequest *SignatureRequest `json:"-"`
+	Template         *Template         `json:"-"`
+}
+
+// GetEvent returns Event
+func (e *EventCallback) GetEvent() *Event {
+	if e != nil {
+		return e.Event
+	}
+	return nil
+}
+
+// GetSignatureRequest returns SignatureRequest
+func (e *EventCallback) GetSignatureRequest() *SignatureRequest {
+	if e != nil {
+		return e.SignatureRequest
+	}
+	return nil
+}
+
+// GetTemplate returns Template
+func (e *EventCallback) GetTemplate() *Template {
+	if e != nil {
+		return e.Template
+	}
+	return nil
+}
+
+// GetEventTime returns EventTime
+func (e *Event) GetEventTime() string {
+	if e != nil {
+		return e.EventTime
+	}
+	return ""
+}
+
+// GetEventType returns EventType
+func (e *Event) GetEventType() string {
+	if e != nil {
+		return e.EventType
+	}
+	return ""
+}
+
+// GetEventHash returns EventHash
+func (e *Event) GetEventHash() string {
+	if e != nil {
+		return e.EventHash
+	}
+	return ""
+}
+
+// GetEventMetadata returns EventMetadata
+func (e *Event) GetEventMetadata() *EventMetadata {
+	if e != nil {
+		return e.EventMetadata
+	}
+	return nil
+}
+
+// GetSignatureRequest returns SignatureRequest
+func (e *Event) GetSignatureRequest() *SignatureRequest {
+	if e != nil {
+		return e.SignatureRequest
+	}
+	return nil
+}
+
+// GetTemplate returns Template
+func (e *Event) GetTemplate() *Template {
+	if e != nil {
+		return e.Template
+	}
+	return nil
+}
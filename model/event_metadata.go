@@ -0,0 +1,41 @@
+package model
+
+// EventMetadata describes what an Event refers to
+type EventMetadata struct {
+	RelatedSignatureID   string `json:"related_signature_id"`    // The id of the signature the event is about, when it is about a single signer.
+	ReportedForAccountID string `json:"reported_for_account_id"` // The account the event was reported for.
+	ReportedForAppID     string `json:"reported_for_app_id"`     // The api app the event was reported for, for app callbacks.
+	EventMessage         string `json:"event_message"`           // Extra detail about the event, eg: the reason a file failed to process.
+}
+
+// GetRelatedSignatureID returns RelatedSignatureID
+func (e *EventMetadata) GetRelatedSignatureID() string {
+	if e != nil {
+		return e.RelatedSignatureID
+	}
+	return ""
+}
+
+// GetReportedForAccountID returns ReportedForAccountID
+func (e *EventMetadata) GetReportedForAccountID() string {
+	if e != nil {
+		return e.ReportedForAccountID
+	}
+	return ""
+}
+
+// GetReportedForAppID returns ReportedForAppID
+func (e *EventMetadata) GetReportedForAppID() string {
+	if e != nil {
+		return e.ReportedForAppID
+	}
+	return ""
+}
+
+// GetEventMessage returns EventMessage
+func (e *EventMetadata) GetEventMessage() string {
+	if e != nil {
+		return e.EventMessage
+	}
+	return ""
+}
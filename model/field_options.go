@@ -0,0 +1,36 @@
+package model
+
+import "fmt"
+
+// Date formats accepted by FieldOptions.DateFormat
+const (
+	DateFormatMonthDayYearSlash = "MM / DD / YYYY"
+	DateFormatMonthDayYearDash  = "MM - DD - YYYY"
+	DateFormatDayMonthYearSlash = "DD / MM / YYYY"
+	DateFormatDayMonthYearDash  = "DD - MM - YYYY"
+	DateFormatYearMonthDaySlash = "YYYY / MM / DD"
+	DateFormatYearMonthDayDash  = "YYYY - MM - DD"
+)
+
+// FieldOptions controls how fields are rendered on the signed document
+type FieldOptions struct {
+	DateFormat string `field:"date_format"` // One of the DateFormat constants.
+}
+
+// GetDateFormat returns DateFormat
+func (f *FieldOptions) GetDateFormat() string {
+	if f != nil {
+		return f.DateFormat
+	}
+	return ""
+}
+
+// Validate returns an error when DateFormat is not one HelloSign accepts
+func (f *FieldOptions) Validate() error {
+	switch f.GetDateFormat() {
+	case "", DateFormatMonthDayYearSlash, DateFormatMonthDayYearDash, DateFormatDayMonthYearSlash,
+		DateFormatDayMonthYearDash, DateFormatYearMonthDaySlash, DateFormatYearMonthDayDash:
+		return nil
+	}
+	return fmt.Errorf("unknown date_format %q", f.GetDateFormat())
+}
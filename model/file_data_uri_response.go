@@ -0,0 +1,19 @@
+package model
+
+// FileDataURIResponse contains signature request files encoded as a data URI
+type FileDataURIResponse struct {
+	DataURI string `json:"data_uri"` // The base64 encoded pdf, e.g. "data:application/pdf;base64,...".
+}
+
+// DownloadFormField returns get_data_uri, which asks the files endpoints for this response
+func (f *FileDataURIResponse) DownloadFormField() string {
+	return "get_data_uri"
+}
+
+// GetDataURI returns DataURI
+func (f *FileDataURIResponse) GetDataURI() string {
+	if f != nil {
+		return f.DataURI
+	}
+	return ""
+}
@@ -0,0 +1,25 @@
+package model
+
+import "io"
+
+// FileUpload is a document streamed from Reader rather than read from a path on disk
+type FileUpload struct {
+	Name   string    // The file name sent with the upload, eg: offer_letter.pdf
+	Reader io.Reader // The contents of the document.
+}
+
+// GetName returns Name
+func (f *FileUpload) GetName() string {
+	if f != nil {
+		return f.Name
+	}
+	return ""
+}
+
+// GetReader returns Reader
+func (f *FileUpload) GetReader() io.Reader {
+	if f != nil {
+		return f.Reader
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package model
+
+// FileURLResponse contains a temporary link for downloading signature request files
+type FileURLResponse struct {
+	FileURL   string `json:"file_url"`   // URL of the file, valid until ExpiresAt.
+	ExpiresAt int    `json:"expires_at"` // When the link expires.
+}
+
+// DownloadFormField returns get_url, which asks the files endpoints for this response
+func (f *FileURLResponse) DownloadFormField() string {
+	return "get_url"
+}
+
+// GetFileURL returns FileURL
+func (f *FileURLResponse) GetFileURL() string {
+	if f != nil {
+		return f.FileURL
+	}
+	return ""
+}
+
+// GetExpiresAt returns ExpiresAt
+func (f *FileURLResponse) GetExpiresAt() int {
+	if f != nil {
+		return f.ExpiresAt
+	}
+	return 0
+}
@@ -0,0 +1,36 @@
+package model
+
+import "fmt"
+
+// Languages HelloSign can show the signing page and emails in, set with Signer.Language
+const (
+	LanguageEnglishUS          = "en-US"
+	LanguageFrench             = "fr-FR"
+	LanguageGerman             = "de-DE"
+	LanguageSwedish            = "sv-SE"
+	LanguageChineseSimplified  = "zh-CN"
+	LanguageChineseTraditional = "zh-TW"
+	LanguageDanish             = "da-DK"
+	LanguageDutch              = "nl-NL"
+	LanguageSpanish            = "es-ES"
+	LanguageSpanishMexico      = "es-MX"
+	LanguagePortugueseBrazil   = "pt-BR"
+	LanguagePolish             = "pl-PL"
+	LanguageJapanese           = "ja-JP"
+	LanguageKorean             = "ko-KR"
+	LanguageItalian            = "it-IT"
+	LanguageRussian            = "ru-RU"
+	LanguageNorwegian          = "nb-NO"
+)
+
+// ValidateLanguage returns an error when language is set but is not one of the Language constants
+func ValidateLanguage(language string) error {
+	switch language {
+	case "", LanguageEnglishUS, LanguageFrench, LanguageGerman, LanguageSwedish, LanguageChineseSimplified,
+		LanguageChineseTraditional, LanguageDanish, LanguageDutch, LanguageSpanish, LanguageSpanishMexico,
+		LanguagePortugueseBrazil, LanguagePolish, LanguageJapanese, LanguageKorean, LanguageItalian,
+		LanguageRussian, LanguageNorwegian:
+		return nil
+	}
+	return fmt.Errorf("unsupported language %q", language)
+}
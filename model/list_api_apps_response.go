@@ -0,0 +1,22 @@
+package model
+
+type ListAPIAppsResponse struct {
+	ListInfo *ListInfo `json:"list_info"`
+	APIApps  []*APIApp `json:"api_apps"`
+}
+
+// GetListInfo returns ListInfo
+func (l *ListAPIAppsResponse) GetListInfo() *ListInfo {
+	if l != nil {
+		return l.ListInfo
+	}
+	return nil
+}
+
+// GetAPIApps returns APIApps
+func (l *ListAPIAppsResponse) GetAPIApps() []*APIApp {
+	if l != nil {
+		return l.APIApps
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package model
+
+type ListBulkSendJobsResponse struct {
+	ListInfo     *ListInfo      `json:"list_info"`
+	BulkSendJobs []*BulkSendJob `json:"bulk_send_jobs"`
+}
+
+// GetListInfo returns ListInfo
+func (l *ListBulkSendJobsResponse) GetListInfo() *ListInfo {
+	if l != nil {
+		return l.ListInfo
+	}
+	return nil
+}
+
+// GetBulkSendJobs returns BulkSendJobs
+func (l *ListBulkSendJobsResponse) GetBulkSendJobs() []*BulkSendJob {
+	if l != nil {
+		return l.BulkSendJobs
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package model
+
+// ListParams contains the paging and filtering parameters accepted by the list endpoints
+type ListParams struct {
+	Page      int    // Which page number of the list to return. Defaults to 1.
+	PageSize  int    // Number of objects to be returned per page. Must be between 1 and 100. Defaults to 20.
+	Query     string // String that includes search terms and/or fields to be used to filter the results.
+	AccountID string // Which account to return results for. Must be a team member. Use "all" for all team members.
+}
+
+// GetPage returns Page
+func (l *ListParams) GetPage() int {
+	if l != nil {
+		return l.Page
+	}
+	return 0
+}
+
+// GetPageSize returns PageSize
+func (l *ListParams) GetPageSize() int {
+	if l != nil {
+		return l.PageSize
+	}
+	return 0
+}
+
+// GetQuery returns Query
+func (l *ListParams) GetQuery() string {
+	if l != nil {
+		return l.Query
+	}
+	return ""
+}
+
+// GetAccountID returns AccountID
+func (l *ListParams) GetAccountID() string {
+	if l != nil {
+		return l.AccountID
+	}
+	return ""
+}
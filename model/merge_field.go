@@ -0,0 +1,23 @@
+package model
+
+// MergeField is a field of a template whose value is supplied when sending, e.g. as a custom field
+type MergeField struct {
+	Name string `json:"name"` // The name of the merge field.
+	Type string `json:"type"` // Either "text" or "checkbox".
+}
+
+// GetName returns Name
+func (m *MergeField) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// GetType returns Type
+func (m *MergeField) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+package model
+
+// OAuthData contains the OAuth credentials issued for an account
+type OAuthData struct {
+	AccessToken  string `json:"access_token"`  // The token used to authenticate requests on behalf of the account.
+	TokenType    string `json:"token_type"`    // The type of the access token, eg: Bearer
+	RefreshToken string `json:"refresh_token"` // The token used to obtain a new access token once it expires.
+	ExpiresIn    int    `json:"expires_in"`    // Number of seconds until the access token expires.
+	Scope        string `json:"scope"`         // The comma separated scopes granted to the access token.
+}
+
+// GetAccessToken returns AccessToken
+func (o *OAuthData) GetAccessToken() string {
+	if o != nil {
+		return o.AccessToken
+	}
+	return ""
+}
+
+// GetTokenType returns TokenType
+func (o *OAuthData) GetTokenType() string {
+	if o != nil {
+		return o.TokenType
+	}
+	return ""
+}
+
+// GetRefreshToken returns RefreshToken
+func (o *OAuthData) GetRefreshToken() string {
+	if o != nil {
+		return o.RefreshToken
+	}
+	return ""
+}
+
+// GetExpiresIn returns ExpiresIn
+func (o *OAuthData) GetExpiresIn() int {
+	if o != nil {
+		return o.ExpiresIn
+	}
+	return 0
+}
+
+// GetScope returns Scope
+func (o *OAuthData) GetScope() string {
+	if o != nil {
+		return o.Scope
+	}
+	return ""
+}
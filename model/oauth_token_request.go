@@ -0,0 +1,41 @@
+package model
+
+// OAuthTokenRequest contains the request parameters for exchanging an authorization code for an access token
+type OAuthTokenRequest struct {
+	Code         string `form_field:"code"`          // The code passed to your callback when the user granted access.
+	State        string `form_field:"state"`         // Same as the state you specified earlier.
+	ClientID     string `form_field:"client_id"`     // The API App Client ID.
+	ClientSecret string `form_field:"client_secret"` // The secret token of your app.
+}
+
+// GetCode returns Code
+func (o *OAuthTokenRequest) GetCode() string {
+	if o != nil {
+		return o.Code
+	}
+	return ""
+}
+
+// GetState returns State
+func (o *OAuthTokenRequest) GetState() string {
+	if o != nil {
+		return o.State
+	}
+	return ""
+}
+
+// GetClientID returns ClientID
+func (o *OAuthTokenRequest) GetClientID() string {
+	if o != nil {
+		return o.ClientID
+	}
+	return ""
+}
+
+// GetClientSecret returns ClientSecret
+func (o *OAuthTokenRequest) GetClientSecret() string {
+	if o != nil {
+		return o.ClientSecret
+	}
+	return ""
+}
@@ -0,0 +1,23 @@
+package model
+
+// OperationResult is returned by actions whose response has no body to parse, eg: cancelling a signature request
+type OperationResult struct {
+	StatusCode int       // The HTTP status code of the response.
+	Warnings   []Warning // Any warnings returned with the response.
+}
+
+// GetStatusCode returns StatusCode
+func (o *OperationResult) GetStatusCode() int {
+	if o != nil {
+		return o.StatusCode
+	}
+	return 0
+}
+
+// GetWarnings returns Warnings
+func (o *OperationResult) GetWarnings() []Warning {
+	if o != nil {
+		return o.Warnings
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// RateLimit contains the rate limit state HelloSign reported on a response
+type RateLimit struct {
+	Limit     int // X-Ratelimit-Limit: requests allowed per window.
+	Remaining int // X-Ratelimit-Limit-Remaining: requests left in the current window.
+	Reset     int // X-Ratelimit-Reset: when the window resets, as a unix timestamp.
+}
+
+// GetLimit returns Limit
+func (r *RateLimit) GetLimit() int {
+	if r != nil {
+		return r.Limit
+	}
+	return 0
+}
+
+// GetRemaining returns Remaining
+func (r *RateLimit) GetRemaining() int {
+	if r != nil {
+		return r.Remaining
+	}
+	return 0
+}
+
+// GetReset returns Reset
+func (r *RateLimit) GetReset() int {
+	if r != nil {
+		return r.Reset
+	}
+	return 0
+}
+
+// GetResetTime returns Reset as a time.Time
+func (r *RateLimit) GetResetTime() time.Time {
+	return time.Unix(int64(r.GetReset()), 0)
+}
@@ -0,0 +1,53 @@
+package model
+
+// Report confirms a report has been queued and will be emailed once ready
+type Report struct {
+	Success     string   `json:"success"`     // A message indicating the report is being processed.
+	StartDate   string   `json:"start_date"`  // The first day of the report, as MM/DD/YYYY.
+	EndDate     string   `json:"end_date"`    // The last day of the report, as MM/DD/YYYY.
+	ReportTypes []string `json:"report_type"` // The types of report requested.
+}
+
+// GetSuccess returns Success
+func (r *Report) GetSuccess() string {
+	if r != nil {
+		return r.Success
+	}
+	return ""
+}
+
+// GetStartDate returns StartDate
+func (r *Report) GetStartDate() string {
+	if r != nil {
+		return r.StartDate
+	}
+	return ""
+}
+
+// GetEndDate returns EndDate
+func (r *Report) GetEndDate() string {
+	if r != nil {
+		return r.EndDate
+	}
+	return ""
+}
+
+// GetReportTypes returns ReportTypes
+func (r *Report) GetReportTypes() []string {
+	if r != nil {
+		return r.ReportTypes
+	}
+	return nil
+}
+
+type ReportResponse struct {
+	Report *Report `json:"report"`
+}
+
+// GetReport returns Report
+func (r *ReportResponse) GetReport() *Report {
+	if r != nil {
+		return r.Report
+	}
+	return nil
+}
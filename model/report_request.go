@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// Report types accepted by ReportRequest.ReportTypes
+const (
+	ReportTypeUserActivity   = "user_activity"
+	ReportTypeDocumentStatus = "document_status"
+)
+
+// ReportRequest contains the request parameters for report/create
+type ReportRequest struct {
+	StartDate   time.Time // The first day of the report. Only the date is sent.
+	EndDate     time.Time // The last day of the report, which must be after StartDate.
+	ReportTypes []string  // One or more of the ReportType constants.
+}
+
+// GetStartDate returns StartDate
+func (r *ReportRequest) GetStartDate() time.Time {
+	if r != nil {
+		return r.StartDate
+	}
+	return time.Time{}
+}
+
+// GetEndDate returns EndDate
+func (r *ReportRequest) GetEndDate() time.Time {
+	if r != nil {
+		return r.EndDate
+	}
+	return time.Time{}
+}
+
+// GetReportTypes returns ReportTypes
+func (r *ReportRequest) GetReportTypes() []string {
+	if r != nil {
+		return r.ReportTypes
+	}
+	return nil
+}
@@ -1,10 +1,15 @@
 package model
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 type ResponseData struct {
 	ApiID       string `json:"api_id"`       // The unique ID for this field.
 	SignatureID string `json:"signature_id"` // The ID of the signature to which this response is linked.
 	Name        string `json:"name"`         // The name of the form field.
-	Value       string `json:"value"`        // The value of the form field.
+	Value       string `json:"value"`        // The value of the form field. Checkbox values are "true" or "false".
 	Required    bool   `json:"required"`     // A boolean value denoting if this field is required.
 	Type        string `json:"type"`         // The type of this form field. See field types
 }
@@ -56,3 +61,26 @@ func (r *ResponseData) GetType() string {
 	}
 	return ""
 }
+
+// UnmarshalJSON decodes the response data, converting the boolean value of a checkbox, or any other non-string value, into Value
+func (r *ResponseData) UnmarshalJSON(data []byte) error {
+	type responseData ResponseData
+	raw := struct {
+		*responseData
+		Value interface{} `json:"value"`
+	}{responseData: (*responseData)(r)}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch value := raw.Value.(type) {
+	case nil:
+		r.Value = ""
+	case string:
+		r.Value = value
+	default:
+		r.Value = fmt.Sprintf("%v", value)
+	}
+	return nil
+}
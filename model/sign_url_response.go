@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type SignURLResponse struct {
 	SignURL   string `json:"sign_url"`   // URL of the signature page to display in the embedded iFrame.
 	ExpiresAt int    `json:"expires_at"` // When the link expires.
@@ -20,3 +22,18 @@ func (s *SignURLResponse) GetExpiresAt() int {
 	}
 	return 0
 }
+
+// GetExpiresAtTime returns ExpiresAt as a time.Time, or the zero time when HelloSign did not return an expiry
+func (s *SignURLResponse) GetExpiresAtTime() time.Time {
+	return unixTime(s.GetExpiresAt())
+}
+
+// IsExpired returns true once the sign URL can no longer be used, so a fresh one must be requested.
+// A URL without an expiry is treated as not expired.
+func (s *SignURLResponse) IsExpired() bool {
+	expiresAt := s.GetExpiresAtTime()
+	if expiresAt.IsZero() {
+		return false
+	}
+	return !time.Now().Before(expiresAt)
+}
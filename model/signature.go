@@ -1,5 +1,19 @@
 package model
 
+import "time"
+
+// Status codes of a Signature
+const (
+	SignatureStatusAwaitingSignature      = "awaiting_signature"
+	SignatureStatusOnHold                 = "on_hold" // Waiting for an earlier signer in the signing order.
+	SignatureStatusSigned                 = "signed"
+	SignatureStatusDeclined               = "declined"
+	SignatureStatusErrorUnknown           = "error_unknown"
+	SignatureStatusErrorFile              = "error_file"
+	SignatureStatusErrorComponentPosition = "error_component_position"
+	SignatureStatusErrorTextTags          = "error_text_tags"
+)
+
 type Signature struct {
 	SignatureID        string  `json:"signature_id"`         // Signature identifier.
 	SignerEmailAddress string  `json:"signer_email_address"` // The email address of the signer.
@@ -118,4 +132,46 @@ func (s *Signature) GetError() *string {
 		return s.Error
 	}
 	return nil
+}
+
+// GetSignedAtTime returns SignedAt as a time.Time, or the zero time if the signer has not signed
+func (s *Signature) GetSignedAtTime() time.Time {
+	return unixTime(s.GetSignedAt())
+}
+
+// GetLastViewedAtTime returns LastViewedAt as a time.Time, or the zero time if the signer has not viewed the request
+func (s *Signature) GetLastViewedAtTime() time.Time {
+	return unixTime(s.GetLastViewedAt())
+}
+
+// GetLastRemindedAtTime returns LastRemindedAt as a time.Time, or the zero time if no reminder was sent
+func (s *Signature) GetLastRemindedAtTime() time.Time {
+	return unixTime(s.GetLastRemindedAt())
+}
+
+// unixTime converts a HelloSign timestamp, where null decodes as 0, into a time.Time
+func unixTime(timestamp int) time.Time {
+	if timestamp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(timestamp), 0)
+}
+
+// IsSigned returns true once the signer has signed
+func (s *Signature) IsSigned() bool {
+	return s.GetStatusCode() == SignatureStatusSigned
+}
+
+// IsDeclined returns true if the signer declined to sign
+func (s *Signature) IsDeclined() bool {
+	return s.GetStatusCode() == SignatureStatusDeclined
+}
+
+// IsPending returns true while the signature is still to be made, including when waiting on an earlier signer
+func (s *Signature) IsPending() bool {
+	switch s.GetStatusCode() {
+	case SignatureStatusAwaitingSignature, SignatureStatusOnHold:
+		return true
+	}
+	return false
 }
\ No newline at end of file
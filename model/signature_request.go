@@ -1,5 +1,10 @@
 package model
 
+import (
+	"strings"
+	"time"
+)
+
 type SignatureRequest struct {
 	TestMode              bool                     `json:"test_mode"`               // Whether this is a test signature request. Test requests have no legal value. Defaults to 0.
 	SignatureRequestID    string                   `json:"signature_request_id"`    // The id of the SignatureRequest.
@@ -8,7 +13,7 @@ type SignatureRequest struct {
 	OriginalTitle         string                   `json:"original_title"`          // Default Label for account.
 	Subject               string                   `json:"subject"`                 // The subject in the email that was initially sent to the signers.
 	Message               string                   `json:"message"`                 // The custom message in the email that was initially sent to the signers.
-	Metadata              map[string]interface{}   `json:"metadata"`                // The metadata attached to the signature request.
+	Metadata              map[string]string        `json:"metadata"`                // The metadata attached to the signature request, as sent on creation.
 	CreatedAt             int                      `json:"created_at"`              // Time the signature request was created.
 	IsComplete            bool                     `json:"is_complete"`             // Whether or not the SignatureRequest has been fully executed by all signers.
 	IsDeclined            bool                     `json:"is_declined"`             // Whether or not the SignatureRequest has been declined by a signer.
@@ -22,6 +27,7 @@ type SignatureRequest struct {
 	ResponseData          []*ResponseData          `json:"response_data"`           // An array of form field objects containing the name, value, and type of each textbox or checkmark field filled in by the signers.
 	Signatures            []*Signature             `json:"signatures"`              // An array of signature objects, 1 for each signer.
 	Warnings              []*Warning               `json:"warnings"`                // An array of warning objects.
+	Attachments           []*Attachment            `json:"attachments"`             // The attachments requested from the signers, including any they uploaded.
 	TemplateIDs           []string                 `json:"template_ids"`
 	ClientID              string                   `json:"client_id"`
 }
@@ -83,7 +89,7 @@ func (s *SignatureRequest) GetMessage() string {
 }
 
 // GetMetadata returns Metadata
-func (s *SignatureRequest) GetMetadata() map[string]interface{} {
+func (s *SignatureRequest) GetMetadata() map[string]string {
 	if s != nil {
 		return s.Metadata
 	}
@@ -194,6 +200,14 @@ func (s *SignatureRequest) GetWarnings() []*Warning {
 	return nil
 }
 
+// GetAttachments returns Attachments
+func (s *SignatureRequest) GetAttachments() []*Attachment {
+	if s != nil {
+		return s.Attachments
+	}
+	return nil
+}
+
 // GetTemplateIDs returns TemplateIDs
 func (s *SignatureRequest) GetTemplateIDs() []string {
 	if s != nil {
@@ -209,3 +223,59 @@ func (s *SignatureRequest) GetClientID() string {
 	}
 	return ""
 }
+
+
+// PendingSigners returns the signatures that have not yet been signed or declined.
+// Use GetIsComplete and GetIsDeclined for the state of the request as a whole.
+func (s *SignatureRequest) PendingSigners() []*Signature {
+	pending := []*Signature{}
+	for _, signature := range s.GetSignatures() {
+		if signature.IsPending() {
+			pending = append(pending, signature)
+		}
+	}
+	return pending
+}
+
+// DeclinedSigners returns the signatures whose signer declined to sign
+func (s *SignatureRequest) DeclinedSigners() []*Signature {
+	declined := []*Signature{}
+	for _, signature := range s.GetSignatures() {
+		if signature.IsDeclined() {
+			declined = append(declined, signature)
+		}
+	}
+	return declined
+}
+
+// GetDeclineReason returns the reason given by the first signer who declined, or "" when no one declined
+func (s *SignatureRequest) GetDeclineReason() string {
+	if declined := s.DeclinedSigners(); len(declined) > 0 {
+		return declined[0].GetDeclineReason()
+	}
+	return ""
+}
+
+// GetCompletedAtTime returns when the last signer signed, or the zero time if the request is not complete
+func (s *SignatureRequest) GetCompletedAtTime() time.Time {
+	if !s.GetIsComplete() {
+		return time.Time{}
+	}
+	completedAt := 0
+	for _, signature := range s.GetSignatures() {
+		if signature.GetSignedAt() > completedAt {
+			completedAt = signature.GetSignedAt()
+		}
+	}
+	return unixTime(completedAt)
+}
+
+// IsAwaitingSigner returns true while the signer with the email address still has to sign
+func (s *SignatureRequest) IsAwaitingSigner(email string) bool {
+	for _, signature := range s.PendingSigners() {
+		if strings.EqualFold(signature.GetSignerEmailAddress(), email) {
+			return true
+		}
+	}
+	return false
+}
\ No newline at end of file
@@ -2,6 +2,7 @@ package model
 
 type SignatureRequestResponse struct {
 	SignatureRequest *SignatureRequest `json:"signature_request"`
+	Warnings         []Warning         `json:"warnings"` // Top-level warnings, eg: use of a deprecated parameter.
 }
 
 // GetSignatureRequest returns SignatureRequest
@@ -10,4 +11,12 @@ func (sr *SignatureRequestResponse) GetSignatureRequest() *SignatureRequest {
 		return sr.SignatureRequest
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// GetWarnings returns Warnings
+func (sr *SignatureRequestResponse) GetWarnings() []Warning {
+	if sr != nil {
+		return sr.Warnings
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package model
+
+// SignatureRequestSendRequest contains the request parameters for send
+type SignatureRequestSendRequest struct {
+	TestMode               bool                  `form_field:"test_mode"`
+	FileURL                []string              `form_field:"file_url"`
+	File                   []string              `form_field:"file"`
+	FileUploads            []FileUpload          `form_field:"file"`
+	Title                  string                `form_field:"title"`
+	Subject                string                `form_field:"subject"`
+	Message                string                `form_field:"message"`
+	SigningRedirectURL     string                `form_field:"signing_redirect_url"`
+	Signers                []Signer              `form_field:"signers"`
+	CustomFields           []CustomField         `form_field:"custom_fields"`
+	CCEmailAddresses       []string              `form_field:"cc_email_addresses"`
+	UseTextTags            bool                  `form_field:"use_text_tags"`
+	HideTextTags           bool                  `form_field:"hide_text_tags"`
+	PopulateAutoFillFields bool                  `form_field:"populate_auto_fill_fields"` // Fills in signer details such as name and date for fields HelloSign can auto-fill.
+	ExpiresAt              int64                 `form_field:"expires_at"`                // Unix time after which the request can no longer be signed. Omitted when 0.
+	IsQualifiedSignature   bool                  `form_field:"is_qualified_signature"`    // Sends the request as an EU qualified electronic signature. Requires a HelloSign Business plan.
+	IsEID                  bool                  `form_field:"is_eid"`                    // Requires signers to verify their identity with an eID. Requires a HelloSign Business plan.
+	Metadata               map[string]string     `form_field:"metadata"`
+	FormFieldsPerDocument  [][]DocumentFormField `form_field:"form_fields_per_document"`
+}
+
+// GetTestMode returns TestMode
+func (s *SignatureRequestSendRequest) GetTestMode() bool {
+	if s != nil {
+		return s.TestMode
+	}
+	return false
+}
+
+// GetFileURL returns FileURL
+func (s *SignatureRequestSendRequest) GetFileURL() []string {
+	if s != nil {
+		return s.FileURL
+	}
+	return nil
+}
+
+// GetFile returns File
+func (s *SignatureRequestSendRequest) GetFile() []string {
+	if s != nil {
+		return s.File
+	}
+	return nil
+}
+
+// GetFileUploads returns FileUploads
+func (s *SignatureRequestSendRequest) GetFileUploads() []FileUpload {
+	if s != nil {
+		return s.FileUploads
+	}
+	return nil
+}
+
+// GetTitle returns Title
+func (s *SignatureRequestSendRequest) GetTitle() string {
+	if s != nil {
+		return s.Title
+	}
+	return ""
+}
+
+// GetSubject returns Subject
+func (s *SignatureRequestSendRequest) GetSubject() string {
+	if s != nil {
+		return s.Subject
+	}
+	return ""
+}
+
+// GetMessage returns Message
+func (s *SignatureRequestSendRequest) GetMessage() string {
+	if s != nil {
+		return s.Message
+	}
+	return ""
+}
+
+// GetSigningRedirectURL returns SigningRedirectURL
+func (s *SignatureRequestSendRequest) GetSigningRedirectURL() string {
+	if s != nil {
+		return s.SigningRedirectURL
+	}
+	return ""
+}
+
+// GetSigners returns Signers
+func (s *SignatureRequestSendRequest) GetSigners() []Signer {
+	if s != nil {
+		return s.Signers
+	}
+	return nil
+}
+
+// GetCustomFields returns CustomFields
+func (s *SignatureRequestSendRequest) GetCustomFields() []CustomField {
+	if s != nil {
+		return s.CustomFields
+	}
+	return nil
+}
+
+// GetCCEmailAddresses returns CCEmailAddresses
+func (s *SignatureRequestSendRequest) GetCCEmailAddresses() []string {
+	if s != nil {
+		return s.CCEmailAddresses
+	}
+	return nil
+}
+
+// GetUseTextTags returns UseTextTags
+func (s *SignatureRequestSendRequest) GetUseTextTags() bool {
+	if s != nil {
+		return s.UseTextTags
+	}
+	return false
+}
+
+// GetHideTextTags returns HideTextTags
+func (s *SignatureRequestSendRequest) GetHideTextTags() bool {
+	if s != nil {
+		return s.HideTextTags
+	}
+	return false
+}
+
+// GetPopulateAutoFillFields returns PopulateAutoFillFields
+func (s *SignatureRequestSendRequest) GetPopulateAutoFillFields() bool {
+	if s != nil {
+		return s.PopulateAutoFillFields
+	}
+	return false
+}
+
+// GetExpiresAt returns ExpiresAt
+func (s *SignatureRequestSendRequest) GetExpiresAt() int64 {
+	if s != nil {
+		return s.ExpiresAt
+	}
+	return 0
+}
+
+// GetIsQualifiedSignature returns IsQualifiedSignature
+func (s *SignatureRequestSendRequest) GetIsQualifiedSignature() bool {
+	if s != nil {
+		return s.IsQualifiedSignature
+	}
+	return false
+}
+
+// GetIsEID returns IsEID
+func (s *SignatureRequestSendRequest) GetIsEID() bool {
+	if s != nil {
+		return s.IsEID
+	}
+	return false
+}
+
+// GetMetadata returns Metadata
+func (s *SignatureRequestSendRequest) GetMetadata() map[string]string {
+	if s != nil {
+		return s.Metadata
+	}
+	return nil
+}
+
+// GetFormFieldsPerDocument returns FormFieldsPerDocument
+func (s *SignatureRequestSendRequest) GetFormFieldsPerDocument() [][]DocumentFormField {
+	if s != nil {
+		return s.FormFieldsPerDocument
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package model
+
+// SignatureRequestSendWithTemplateRequest contains the request parameters for send_with_template
+type SignatureRequestSendWithTemplateRequest struct {
+	TestMode             bool              `form_field:"test_mode"`
+	TemplateIDs          []string          `form_field:"template_ids"`
+	Title                string            `form_field:"title"`
+	Subject              string            `form_field:"subject"`
+	Message              string            `form_field:"message"`
+	SigningRedirectURL   string            `form_field:"signing_redirect_url"`
+	Signers              []Signer          `form_field:"signers"`
+	CCs                  []CCRole          `form_field:"ccs"`
+	CustomFields         []CustomField     `form_field:"custom_fields"`
+	ExpiresAt            int64             `form_field:"expires_at"`             // Unix time after which the request can no longer be signed. Omitted when 0.
+	IsQualifiedSignature bool              `form_field:"is_qualified_signature"` // Sends the request as an EU qualified electronic signature. Requires a HelloSign Business plan.
+	IsEID                bool              `form_field:"is_eid"`                 // Requires signers to verify their identity with an eID. Requires a HelloSign Business plan.
+	Metadata             map[string]string `form_field:"metadata"`
+}
+
+// GetTestMode returns TestMode
+func (s *SignatureRequestSendWithTemplateRequest) GetTestMode() bool {
+	if s != nil {
+		return s.TestMode
+	}
+	return false
+}
+
+// GetTemplateIDs returns TemplateIDs
+func (s *SignatureRequestSendWithTemplateRequest) GetTemplateIDs() []string {
+	if s != nil {
+		return s.TemplateIDs
+	}
+	return nil
+}
+
+// GetTitle returns Title
+func (s *SignatureRequestSendWithTemplateRequest) GetTitle() string {
+	if s != nil {
+		return s.Title
+	}
+	return ""
+}
+
+// GetSubject returns Subject
+func (s *SignatureRequestSendWithTemplateRequest) GetSubject() string {
+	if s != nil {
+		return s.Subject
+	}
+	return ""
+}
+
+// GetMessage returns Message
+func (s *SignatureRequestSendWithTemplateRequest) GetMessage() string {
+	if s != nil {
+		return s.Message
+	}
+	return ""
+}
+
+// GetSigningRedirectURL returns SigningRedirectURL
+func (s *SignatureRequestSendWithTemplateRequest) GetSigningRedirectURL() string {
+	if s != nil {
+		return s.SigningRedirectURL
+	}
+	return ""
+}
+
+// GetSigners returns Signers
+func (s *SignatureRequestSendWithTemplateRequest) GetSigners() []Signer {
+	if s != nil {
+		return s.Signers
+	}
+	return nil
+}
+
+// GetCCs returns CCs
+func (s *SignatureRequestSendWithTemplateRequest) GetCCs() []CCRole {
+	if s != nil {
+		return s.CCs
+	}
+	return nil
+}
+
+// GetCustomFields returns CustomFields
+func (s *SignatureRequestSendWithTemplateRequest) GetCustomFields() []CustomField {
+	if s != nil {
+		return s.CustomFields
+	}
+	return nil
+}
+
+// GetExpiresAt returns ExpiresAt
+func (s *SignatureRequestSendWithTemplateRequest) GetExpiresAt() int64 {
+	if s != nil {
+		return s.ExpiresAt
+	}
+	return 0
+}
+
+// GetIsQualifiedSignature returns IsQualifiedSignature
+func (s *SignatureRequestSendWithTemplateRequest) GetIsQualifiedSignature() bool {
+	if s != nil {
+		return s.IsQualifiedSignature
+	}
+	return false
+}
+
+// GetIsEID returns IsEID
+func (s *SignatureRequestSendWithTemplateRequest) GetIsEID() bool {
+	if s != nil {
+		return s.IsEID
+	}
+	return false
+}
+
+// GetMetadata returns Metadata
+func (s *SignatureRequestSendWithTemplateRequest) GetMetadata() map[string]string {
+	if s != nil {
+		return s.Metadata
+	}
+	return nil
+}
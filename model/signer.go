@@ -1,10 +1,18 @@
 package model
 
+const (
+	SMSPhoneNumberTypeAuthentication = "authentication"
+	SMSPhoneNumberTypeDelivery       = "delivery"
+)
+
 type Signer struct {
-	Name  string `field:"name"`
-	Email string `field:"email_address"`
-	Order int    `field:"order"`
-	Pin   string `field:"pin"`
+	Name               string `field:"name"`
+	Email              string `field:"email_address"`
+	Order              int    `field:"order"`
+	Pin                string `field:"pin"`
+	SMSPhoneNumber     string `field:"sms_phone_number"`      // Optional. Sends the signing link or an authentication code by SMS.
+	SMSPhoneNumberType string `field:"sms_phone_number_type"` // Either SMSPhoneNumberTypeAuthentication or SMSPhoneNumberTypeDelivery.
+	Language           string `field:"language"`              // Optional. One of the Language constants, eg: LanguageFrench. Defaults to the account's language.
 }
 
 // GetName returns Signer's Name
@@ -37,4 +45,28 @@ func (s *Signer) GetPin() string {
 		return s.Pin
 	}
 	return ""
-}
\ No newline at end of file
+}
+
+// GetSMSPhoneNumber returns Signer's SMSPhoneNumber
+func (s *Signer) GetSMSPhoneNumber() string {
+	if s != nil {
+		return s.SMSPhoneNumber
+	}
+	return ""
+}
+
+// GetSMSPhoneNumberType returns Signer's SMSPhoneNumberType
+func (s *Signer) GetSMSPhoneNumberType() string {
+	if s != nil {
+		return s.SMSPhoneNumberType
+	}
+	return ""
+}
+
+// GetLanguage returns Signer's Language
+func (s *Signer) GetLanguage() string {
+	if s != nil {
+		return s.Language
+	}
+	return ""
+}
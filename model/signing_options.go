@@ -0,0 +1,50 @@
+package model
+
+// SigningOptions controls which methods signers may use to create their signature
+type SigningOptions struct {
+	Draw        bool   `field:"draw"`
+	Type        bool   `field:"type"`
+	Upload      bool   `field:"upload"`
+	Phone       bool   `field:"phone"`
+	DefaultType string `field:"default_type"` // Optional. The method selected when the signer opens the signature dialog, e.g. "draw".
+}
+
+// GetDraw returns Draw
+func (s *SigningOptions) GetDraw() bool {
+	if s != nil {
+		return s.Draw
+	}
+	return false
+}
+
+// GetType returns Type
+func (s *SigningOptions) GetType() bool {
+	if s != nil {
+		return s.Type
+	}
+	return false
+}
+
+// GetUpload returns Upload
+func (s *SigningOptions) GetUpload() bool {
+	if s != nil {
+		return s.Upload
+	}
+	return false
+}
+
+// GetPhone returns Phone
+func (s *SigningOptions) GetPhone() bool {
+	if s != nil {
+		return s.Phone
+	}
+	return false
+}
+
+// GetDefaultType returns DefaultType
+func (s *SigningOptions) GetDefaultType() string {
+	if s != nil {
+		return s.DefaultType
+	}
+	return ""
+}
@@ -0,0 +1,32 @@
+package model
+
+// Team contains information about a team and its members
+type Team struct {
+	Name            string    `json:"name"`             // The name of the team.
+	Accounts        []Account `json:"accounts"`         // The members of the team.
+	InvitedAccounts []Account `json:"invited_accounts"` // Accounts that have been invited but have not yet joined the team.
+}
+
+// GetName returns Name
+func (t *Team) GetName() string {
+	if t != nil {
+		return t.Name
+	}
+	return ""
+}
+
+// GetAccounts returns Accounts
+func (t *Team) GetAccounts() []Account {
+	if t != nil {
+		return t.Accounts
+	}
+	return nil
+}
+
+// GetInvitedAccounts returns InvitedAccounts
+func (t *Team) GetInvitedAccounts() []Account {
+	if t != nil {
+		return t.InvitedAccounts
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package model
+
+type TeamResponse struct {
+	Team *Team `json:"team"`
+}
+
+// GetTeam returns Team
+func (t *TeamResponse) GetTeam() *Team {
+	if t != nil {
+		return t.Team
+	}
+	return nil
+}
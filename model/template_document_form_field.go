@@ -10,6 +10,8 @@ type TemplateDocumentFormField struct {
 	Height      int          `json:"height"`
 	Required    bool         `json:"required"`
 	SignerRoles []SignerRole `json:"signer_roles"`
+	Signer      string       `json:"signer"` // The signer role the field belongs to, as its 1-based position in the template's signer roles.
+	Page        int          `json:"page"`   // The page of the document the field is on.
 }
 
 // GetAPIId returns APIId
@@ -83,3 +85,19 @@ func (d *TemplateDocumentFormField) GetSignerRoles() []SignerRole {
 	}
 	return nil
 }
+
+// GetSigner returns Signer
+func (d *TemplateDocumentFormField) GetSigner() string {
+	if d != nil {
+		return d.Signer
+	}
+	return ""
+}
+
+// GetPage returns Page
+func (d *TemplateDocumentFormField) GetPage() int {
+	if d != nil {
+		return d.Page
+	}
+	return 0
+}
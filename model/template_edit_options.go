@@ -0,0 +1,77 @@
+package model
+
+// TemplateEditOptions contains the optional parameters for embedded/edit_url
+type TemplateEditOptions struct {
+	TestMode            bool         `form_field:"test_mode"`
+	CCRoles             []string     `form_field:"cc_roles"`              // The CC roles that must be assigned when using the template.
+	MergeFields         []MergeField `form_field:"merge_fields"`          // Replaces the template's merge fields. Sent as JSON.
+	SkipSignerRoles     bool         `form_field:"skip_signer_roles"`     // Skips the signer roles step of the editor.
+	SkipSubjectMessage  bool         `form_field:"skip_subject_message"`  // Skips the subject and message step of the editor.
+	ForceSignerRoles    bool         `form_field:"force_signer_roles"`    // Prevents the editor from changing signer roles.
+	ForceSubjectMessage bool         `form_field:"force_subject_message"` // Prevents the editor from changing the subject and message.
+	PreviewOnly         bool         `form_field:"preview_only"`          // Shows a preview of the template without allowing changes.
+}
+
+// GetTestMode returns TestMode
+func (t *TemplateEditOptions) GetTestMode() bool {
+	if t != nil {
+		return t.TestMode
+	}
+	return false
+}
+
+// GetCCRoles returns CCRoles
+func (t *TemplateEditOptions) GetCCRoles() []string {
+	if t != nil {
+		return t.CCRoles
+	}
+	return nil
+}
+
+// GetMergeFields returns MergeFields
+func (t *TemplateEditOptions) GetMergeFields() []MergeField {
+	if t != nil {
+		return t.MergeFields
+	}
+	return nil
+}
+
+// GetSkipSignerRoles returns SkipSignerRoles
+func (t *TemplateEditOptions) GetSkipSignerRoles() bool {
+	if t != nil {
+		return t.SkipSignerRoles
+	}
+	return false
+}
+
+// GetSkipSubjectMessage returns SkipSubjectMessage
+func (t *TemplateEditOptions) GetSkipSubjectMessage() bool {
+	if t != nil {
+		return t.SkipSubjectMessage
+	}
+	return false
+}
+
+// GetForceSignerRoles returns ForceSignerRoles
+func (t *TemplateEditOptions) GetForceSignerRoles() bool {
+	if t != nil {
+		return t.ForceSignerRoles
+	}
+	return false
+}
+
+// GetForceSubjectMessage returns ForceSubjectMessage
+func (t *TemplateEditOptions) GetForceSubjectMessage() bool {
+	if t != nil {
+		return t.ForceSubjectMessage
+	}
+	return false
+}
+
+// GetPreviewOnly returns PreviewOnly
+func (t *TemplateEditOptions) GetPreviewOnly() bool {
+	if t != nil {
+		return t.PreviewOnly
+	}
+	return false
+}
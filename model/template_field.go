@@ -0,0 +1,104 @@
+package model
+
+// TemplateField is a template form field and the document it is placed on, as returned by GetTemplateFieldSchema
+type TemplateField struct {
+	DocumentIndex int    `json:"document_index"` // The index of the document within the template.
+	DocumentName  string `json:"document_name"`  // The name of the document within the template.
+	APIId         string `json:"api_id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"` // The type of the field, eg: signature, date_signed, checkbox
+	Page          int    `json:"page"`
+	X             int    `json:"x"`
+	Y             int    `json:"y"`
+	Width         int    `json:"width"`
+	Height        int    `json:"height"`
+	Required      bool   `json:"required"`
+}
+
+// GetDocumentIndex returns DocumentIndex
+func (t *TemplateField) GetDocumentIndex() int {
+	if t != nil {
+		return t.DocumentIndex
+	}
+	return 0
+}
+
+// GetDocumentName returns DocumentName
+func (t *TemplateField) GetDocumentName() string {
+	if t != nil {
+		return t.DocumentName
+	}
+	return ""
+}
+
+// GetAPIId returns APIId
+func (t *TemplateField) GetAPIId() string {
+	if t != nil {
+		return t.APIId
+	}
+	return ""
+}
+
+// GetName returns Name
+func (t *TemplateField) GetName() string {
+	if t != nil {
+		return t.Name
+	}
+	return ""
+}
+
+// GetType returns Type
+func (t *TemplateField) GetType() string {
+	if t != nil {
+		return t.Type
+	}
+	return ""
+}
+
+// GetPage returns Page
+func (t *TemplateField) GetPage() int {
+	if t != nil {
+		return t.Page
+	}
+	return 0
+}
+
+// GetX returns X
+func (t *TemplateField) GetX() int {
+	if t != nil {
+		return t.X
+	}
+	return 0
+}
+
+// GetY returns Y
+func (t *TemplateField) GetY() int {
+	if t != nil {
+		return t.Y
+	}
+	return 0
+}
+
+// GetWidth returns Width
+func (t *TemplateField) GetWidth() int {
+	if t != nil {
+		return t.Width
+	}
+	return 0
+}
+
+// GetHeight returns Height
+func (t *TemplateField) GetHeight() int {
+	if t != nil {
+		return t.Height
+	}
+	return 0
+}
+
+// GetRequired returns Required
+func (t *TemplateField) GetRequired() bool {
+	if t != nil {
+		return t.Required
+	}
+	return false
+}
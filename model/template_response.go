@@ -0,0 +1,13 @@
+package model
+
+type TemplateResponse struct {
+	Template *Template `json:"template"`
+}
+
+// GetTemplate returns Template
+func (t *TemplateResponse) GetTemplate() *Template {
+	if t != nil {
+		return t.Template
+	}
+	return nil
+}
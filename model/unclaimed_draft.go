@@ -0,0 +1,71 @@
+package model
+
+// UnclaimedDraft contains information about a draft which a user can claim and then send or sign
+type UnclaimedDraft struct {
+	SignatureRequestID    string `json:"signature_request_id"`    // The ID of the signature request that is represented by this UnclaimedDraft.
+	ClaimURL              string `json:"claim_url"`               // The URL to be used to claim this UnclaimedDraft.
+	SigningRedirectURL    string `json:"signing_redirect_url"`    // The URL you want signers redirected to after they successfully sign.
+	RequestingRedirectURL string `json:"requesting_redirect_url"` // The URL you want the requester redirected to after they successfully request a signature.
+	ExpiresAt             int    `json:"expires_at"`              // When the link expires.
+	TestMode              bool   `json:"test_mode"`               // Whether this is a test draft.
+}
+
+type UnclaimedDraftResponse struct {
+	UnclaimedDraft *UnclaimedDraft `json:"unclaimed_draft"`
+}
+
+// GetSignatureRequestID returns SignatureRequestID
+func (u *UnclaimedDraft) GetSignatureRequestID() string {
+	if u != nil {
+		return u.SignatureRequestID
+	}
+	return ""
+}
+
+// GetClaimURL returns ClaimURL
+func (u *UnclaimedDraft) GetClaimURL() string {
+	if u != nil {
+		return u.ClaimURL
+	}
+	return ""
+}
+
+// GetSigningRedirectURL returns SigningRedirectURL
+func (u *UnclaimedDraft) GetSigningRedirectURL() string {
+	if u != nil {
+		return u.SigningRedirectURL
+	}
+	return ""
+}
+
+// GetRequestingRedirectURL returns RequestingRedirectURL
+func (u *UnclaimedDraft) GetRequestingRedirectURL() string {
+	if u != nil {
+		return u.RequestingRedirectURL
+	}
+	return ""
+}
+
+// GetExpiresAt returns ExpiresAt
+func (u *UnclaimedDraft) GetExpiresAt() int {
+	if u != nil {
+		return u.ExpiresAt
+	}
+	return 0
+}
+
+// GetTestMode returns TestMode
+func (u *UnclaimedDraft) GetTestMode() bool {
+	if u != nil {
+		return u.TestMode
+	}
+	return false
+}
+
+// GetUnclaimedDraft returns UnclaimedDraft
+func (u *UnclaimedDraftResponse) GetUnclaimedDraft() *UnclaimedDraft {
+	if u != nil {
+		return u.UnclaimedDraft
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package model
+
+const (
+	UnclaimedDraftTypeSendDocument     = "send_document"     // A claimable file which the claimer prepares and sends.
+	UnclaimedDraftTypeRequestSignature = "request_signature" // A claimable signature request for the given signers.
+)
+
+// UnclaimedDraftRequest contains the request parameters for unclaimed_draft/create
+type UnclaimedDraftRequest struct {
+	TestMode              bool                  `form_field:"test_mode"`
+	Type                  string                `form_field:"type"`
+	FileURL               []string              `form_field:"file_url"`
+	File                  []string              `form_field:"file"`
+	FileUploads           []FileUpload          `form_field:"file"`
+	Subject               string                `form_field:"subject"`
+	Message               string                `form_field:"message"`
+	SigningRedirectURL    string                `form_field:"signing_redirect_url"`
+	Signers               []Signer              `form_field:"signers"`
+	CCEmailAddresses      []string              `form_field:"cc_email_addresses"`
+	UseTextTags           bool                  `form_field:"use_text_tags"`
+	HideTextTags          bool                  `form_field:"hide_text_tags"`
+	Metadata              map[string]string     `form_field:"metadata"`
+	FormFieldsPerDocument [][]DocumentFormField `form_field:"form_fields_per_document"`
+}
+
+// GetTestMode returns TestMode
+func (u *UnclaimedDraftRequest) GetTestMode() bool {
+	if u != nil {
+		return u.TestMode
+	}
+	return false
+}
+
+// GetType returns Type
+func (u *UnclaimedDraftRequest) GetType() string {
+	if u != nil {
+		return u.Type
+	}
+	return ""
+}
+
+// GetFileURL returns FileURL
+func (u *UnclaimedDraftRequest) GetFileURL() []string {
+	if u != nil {
+		return u.FileURL
+	}
+	return nil
+}
+
+// GetFile returns File
+func (u *UnclaimedDraftRequest) GetFile() []string {
+	if u != nil {
+		return u.File
+	}
+	return nil
+}
+
+// GetFileUploads returns FileUploads
+func (u *UnclaimedDraftRequest) GetFileUploads() []FileUpload {
+	if u != nil {
+		return u.FileUploads
+	}
+	return nil
+}
+
+// GetSubject returns Subject
+func (u *UnclaimedDraftRequest) GetSubject() string {
+	if u != nil {
+		return u.Subject
+	}
+	return ""
+}
+
+// GetMessage returns Message
+func (u *UnclaimedDraftRequest) GetMessage() string {
+	if u != nil {
+		return u.Message
+	}
+	return ""
+}
+
+// GetSigningRedirectURL returns SigningRedirectURL
+func (u *UnclaimedDraftRequest) GetSigningRedirectURL() string {
+	if u != nil {
+		return u.SigningRedirectURL
+	}
+	return ""
+}
+
+// GetSigners returns Signers
+func (u *UnclaimedDraftRequest) GetSigners() []Signer {
+	if u != nil {
+		return u.Signers
+	}
+	return nil
+}
+
+// GetCCEmailAddresses returns CCEmailAddresses
+func (u *UnclaimedDraftRequest) GetCCEmailAddresses() []string {
+	if u != nil {
+		return u.CCEmailAddresses
+	}
+	return nil
+}
+
+// GetUseTextTags returns UseTextTags
+func (u *UnclaimedDraftRequest) GetUseTextTags() bool {
+	if u != nil {
+		return u.UseTextTags
+	}
+	return false
+}
+
+// GetHideTextTags returns HideTextTags
+func (u *UnclaimedDraftRequest) GetHideTextTags() bool {
+	if u != nil {
+		return u.HideTextTags
+	}
+	return false
+}
+
+// GetMetadata returns Metadata
+func (u *UnclaimedDraftRequest) GetMetadata() map[string]string {
+	if u != nil {
+		return u.Metadata
+	}
+	return nil
+}
+
+// GetFormFieldsPerDocument returns FormFieldsPerDocument
+func (u *UnclaimedDraftRequest) GetFormFieldsPerDocument() [][]DocumentFormField {
+	if u != nil {
+		return u.FormFieldsPerDocument
+	}
+	return nil
+}
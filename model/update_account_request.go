@@ -0,0 +1,23 @@
+package model
+
+// UpdateAccountRequest contains the request parameters for updating the authenticated account
+type UpdateAccountRequest struct {
+	CallbackURL string `form_field:"callback_url"`
+	Locale      string `form_field:"locale"`
+}
+
+// GetCallbackURL returns CallbackURL
+func (u *UpdateAccountRequest) GetCallbackURL() string {
+	if u != nil {
+		return u.CallbackURL
+	}
+	return ""
+}
+
+// GetLocale returns Locale
+func (u *UpdateAccountRequest) GetLocale() string {
+	if u != nil {
+		return u.Locale
+	}
+	return ""
+}
@@ -0,0 +1,68 @@
+package model
+
+// UpdateTemplateFilesRequest contains the request parameters for replacing the documents of an existing template
+type UpdateTemplateFilesRequest struct {
+	TestMode    bool         `form_field:"test_mode"`
+	ClientID    string       `form_field:"client_id"` // Required when the template was created using an embedded flow.
+	File        []string     `form_field:"file"`
+	FileUploads []FileUpload `form_field:"file"`
+	FileURL     []string     `form_field:"file_url"`
+	Subject     string       `form_field:"subject"` // The new default subject used in the request email.
+	Message     string       `form_field:"message"` // The new default message used in the request email.
+}
+
+// GetTestMode returns TestMode
+func (u *UpdateTemplateFilesRequest) GetTestMode() bool {
+	if u != nil {
+		return u.TestMode
+	}
+	return false
+}
+
+// GetClientID returns ClientID
+func (u *UpdateTemplateFilesRequest) GetClientID() string {
+	if u != nil {
+		return u.ClientID
+	}
+	return ""
+}
+
+// GetFile returns File
+func (u *UpdateTemplateFilesRequest) GetFile() []string {
+	if u != nil {
+		return u.File
+	}
+	return nil
+}
+
+// GetFileUploads returns FileUploads
+func (u *UpdateTemplateFilesRequest) GetFileUploads() []FileUpload {
+	if u != nil {
+		return u.FileUploads
+	}
+	return nil
+}
+
+// GetFileURL returns FileURL
+func (u *UpdateTemplateFilesRequest) GetFileURL() []string {
+	if u != nil {
+		return u.FileURL
+	}
+	return nil
+}
+
+// GetSubject returns Subject
+func (u *UpdateTemplateFilesRequest) GetSubject() string {
+	if u != nil {
+		return u.Subject
+	}
+	return ""
+}
+
+// GetMessage returns Message
+func (u *UpdateTemplateFilesRequest) GetMessage() string {
+	if u != nil {
+		return u.Message
+	}
+	return ""
+}
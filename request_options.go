@@ -0,0 +1,58 @@
+package hellosign
+
+import (
+	"context"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"net/http"
+	"net/url"
+)
+
+// RequestOptions customises the requests sent by the Client returned from WithRequestOptions
+type RequestOptions struct {
+	Headers http.Header     // Extra headers sent with every request, eg: X-Correlation-ID. Authorization is never overridden.
+	Context context.Context // Optional. Cancels waiting on the RateLimiter and the requests themselves. Defaults to context.Background().
+
+	// OnBehalfOf scopes the signature request and template lists to a team member's account_id, or "all" for the whole team.
+	// It lets team admins use the helpers built on those lists. An AccountID set in the ListParams takes precedence.
+	OnBehalfOf string
+}
+
+// WithRequestOptions returns a copy of the client that applies opts to every request, so options can be set per call:
+//
+//	client.WithRequestOptions(hellosign.RequestOptions{Headers: headers}).GetSignatureRequest(id)
+//
+// The copy records its own LastRateLimit.
+func (m *Client) WithRequestOptions(opts RequestOptions) *Client {
+	c := *m
+	c.requestOptions = opts
+	return &c
+}
+
+// applyRequestOptions – Adds the extra headers to the request, leaving Authorization to setAuthorization
+func (m *Client) applyRequestOptions(request *http.Request) {
+	for key, values := range m.requestOptions.Headers {
+		if http.CanonicalHeaderKey(key) == "Authorization" {
+			continue
+		}
+		request.Header.Del(key)
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+}
+
+// getContext – Returns the Context from the request options, or context.Background() when none was set
+func (m *Client) getContext() context.Context {
+	if m.requestOptions.Context != nil {
+		return m.requestOptions.Context
+	}
+	return context.Background()
+}
+
+// listQuery – Encodes params for a list endpoint, scoped to the OnBehalfOf account unless params name one
+func (m *Client) listQuery(params model.ListParams) url.Values {
+	if params.AccountID == "" {
+		params.AccountID = m.requestOptions.OnBehalfOf
+	}
+	return listParams(params).encode()
+}
@@ -0,0 +1,54 @@
+package hellosign
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how requests rejected with 429 Too Many Requests or a 5xx status are retried
+type RetryPolicy struct {
+	MaxRetries int                             // The number of retries after the first attempt.
+	Backoff    func(attempt int) time.Duration // The wait before retrying the given (0-based) failed attempt. Defaults to DefaultBackoff.
+}
+
+// DefaultBackoff waits 500ms, doubling for every attempt, plus up to 50% random jitter
+func DefaultBackoff(attempt int) time.Duration {
+	wait := 500 * time.Millisecond << uint(attempt)
+	return wait + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// notReadyRetryAfter – Reports whether to retry the given (0-based) failed attempt at something that isn't ready yet, and how long to wait first
+func (r *RetryPolicy) notReadyRetryAfter(attempt int) (time.Duration, bool) {
+	if r == nil || attempt >= r.MaxRetries {
+		return 0, false
+	}
+	if r.Backoff != nil {
+		return r.Backoff(attempt), true
+	}
+	return DefaultBackoff(attempt), true
+}
+
+// retryAfter – Reports whether the response should be retried and how long to wait first.
+// A 429 honours the Retry-After header, in seconds, when HelloSign sends one.
+func (r *RetryPolicy) retryAfter(attempt int, response *http.Response) (time.Duration, bool) {
+	if r == nil || attempt >= r.MaxRetries {
+		return 0, false
+	}
+
+	if response.StatusCode != http.StatusTooManyRequests && response.StatusCode < 500 {
+		return 0, false
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	if r.Backoff != nil {
+		return r.Backoff(attempt), true
+	}
+	return DefaultBackoff(attempt), true
+}
@@ -0,0 +1,106 @@
+package hellosign
+
+import (
+	"errors"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+)
+
+// EmbeddedRequestBuilder builds a model.EmbeddedSignatureRequest one part at a time, checking the required parts in Build.
+type EmbeddedRequestBuilder struct {
+	request model.EmbeddedSignatureRequest
+	ordered bool
+}
+
+// NewEmbeddedRequest starts an EmbeddedRequestBuilder for the api app with clientID
+func NewEmbeddedRequest(clientID, title string) *EmbeddedRequestBuilder {
+	return &EmbeddedRequestBuilder{
+		request: model.EmbeddedSignatureRequest{
+			ClientID: clientID,
+			Title:    title,
+		},
+	}
+}
+
+// AddSigner adds a signer
+func (b *EmbeddedRequestBuilder) AddSigner(name, email string) *EmbeddedRequestBuilder {
+	b.request.Signers = append(b.request.Signers, model.Signer{Name: name, Email: email})
+	return b
+}
+
+// AddFile adds a document read from path when the request is sent
+func (b *EmbeddedRequestBuilder) AddFile(path string) *EmbeddedRequestBuilder {
+	b.request.File = append(b.request.File, path)
+	return b
+}
+
+// AddFileURL adds a document HelloSign downloads from fileURL. Files and file URLs cannot be mixed.
+func (b *EmbeddedRequestBuilder) AddFileURL(fileURL string) *EmbeddedRequestBuilder {
+	b.request.FileURL = append(b.request.FileURL, fileURL)
+	return b
+}
+
+// WithSubject sets the subject of the email sent to the signers
+func (b *EmbeddedRequestBuilder) WithSubject(subject string) *EmbeddedRequestBuilder {
+	b.request.Subject = subject
+	return b
+}
+
+// WithMessage sets the message of the email sent to the signers
+func (b *EmbeddedRequestBuilder) WithMessage(message string) *EmbeddedRequestBuilder {
+	b.request.Message = message
+	return b
+}
+
+// WithMetadata attaches a metadata key and value
+func (b *EmbeddedRequestBuilder) WithMetadata(key, value string) *EmbeddedRequestBuilder {
+	if b.request.Metadata == nil {
+		b.request.Metadata = make(map[string]string)
+	}
+	b.request.Metadata[key] = value
+	return b
+}
+
+// WithSignerOrder makes the signers sign in the order they were added
+func (b *EmbeddedRequestBuilder) WithSignerOrder() *EmbeddedRequestBuilder {
+	b.ordered = true
+	return b
+}
+
+// TestMode marks the request as a test, which has no legal value
+func (b *EmbeddedRequestBuilder) TestMode() *EmbeddedRequestBuilder {
+	b.request.TestMode = true
+	return b
+}
+
+// Build returns the request, or an error if the client id, a signer or a document is missing
+func (b *EmbeddedRequestBuilder) Build() (model.EmbeddedSignatureRequest, error) {
+	if b.request.ClientID == "" {
+		return model.EmbeddedSignatureRequest{}, errors.New("embedded signature request requires a client id")
+	}
+	if len(b.request.Signers) == 0 {
+		return model.EmbeddedSignatureRequest{}, errors.New("embedded signature request requires at least one signer")
+	}
+	if len(b.request.File) == 0 && len(b.request.FileURL) == 0 {
+		return model.EmbeddedSignatureRequest{}, errors.New("embedded signature request requires at least one file or file url")
+	}
+
+	// Copy the slices and maps, so the builder can keep being changed without changing a request already built
+	request := b.request
+	request.Signers = append([]model.Signer(nil), b.request.Signers...)
+	request.CustomFields = append([]model.CustomField(nil), b.request.CustomFields...)
+	request.File = append([]string(nil), b.request.File...)
+	request.FileURL = append([]string(nil), b.request.FileURL...)
+	if b.request.Metadata != nil {
+		request.Metadata = make(map[string]string, len(b.request.Metadata))
+		for key, value := range b.request.Metadata {
+			request.Metadata[key] = value
+		}
+	}
+
+	if b.ordered {
+		for i := range request.Signers {
+			request.Signers[i].Order = i
+		}
+	}
+	return request, nil
+}
@@ -0,0 +1,96 @@
+package hellosign
+
+import (
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestEmbeddedRequestBuilder(t *testing.T) {
+	req, err := NewEmbeddedRequest("0a1b2c3d4e5f", "Offer Letter").
+		AddSigner("Jane Doe", "jane@example.com").
+		AddSigner("John Doe", "john@example.com").
+		AddFile("fixtures/offer_letter.pdf").
+		WithSubject("Your offer").
+		WithMetadata("order_id", "A-42").
+		WithSignerOrder().
+		TestMode().
+		Build()
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, "0a1b2c3d4e5f", req.GetClientID())
+	assert.Equal(t, "Offer Letter", req.GetTitle())
+	assert.Equal(t, "Your offer", req.GetSubject())
+	assert.True(t, req.GetTestMode())
+	assert.Equal(t, []string{"fixtures/offer_letter.pdf"}, req.GetFile())
+	assert.Equal(t, map[string]string{"order_id": "A-42"}, req.GetMetadata())
+	assert.Equal(t, []model.Signer{
+		{Name: "Jane Doe", Email: "jane@example.com", Order: 0},
+		{Name: "John Doe", Email: "john@example.com", Order: 1},
+	}, req.GetSigners())
+
+	client := Client{}
+	params, writer, err := client.marshalMultipartEmbeddedSignatureRequest(req)
+	require.Nil(t, err, "Should build a request that marshals")
+	form := readMultipartForm(t, params, writer)
+	assert.Equal(t, []string{"1"}, form.Value["signers[1][order]"])
+	assert.Equal(t, []string{"A-42"}, form.Value["metadata[order_id]"])
+}
+
+func TestEmbeddedRequestBuilderBuildCopies(t *testing.T) {
+	builder := NewEmbeddedRequest("0a1b2c3d4e5f", "Offer Letter").
+		AddSigner("Jane Doe", "jane@example.com").
+		AddSigner("John Doe", "john@example.com").
+		AddFile("fixtures/offer_letter.pdf").
+		WithMetadata("order_id", "A-42")
+	first, err := builder.Build()
+	require.Nil(t, err, "Should not return error")
+
+	builder.AddSigner("Joe Bloggs", "joe@example.com").
+		AddFile("fixtures/offer_letter_2.pdf").
+		WithMetadata("order_id", "A-43").
+		WithSignerOrder()
+	second, err := builder.Build()
+	require.Nil(t, err, "Should not return error")
+
+	assert.Equal(t, []model.Signer{
+		{Name: "Jane Doe", Email: "jane@example.com"},
+		{Name: "John Doe", Email: "john@example.com"},
+	}, first.GetSigners(), "Should not change the signers of a request already built")
+	assert.Equal(t, []string{"fixtures/offer_letter.pdf"}, first.GetFile())
+	assert.Equal(t, map[string]string{"order_id": "A-42"}, first.GetMetadata())
+
+	assert.Len(t, second.GetSigners(), 3)
+	assert.Equal(t, 2, second.GetSigners()[2].Order)
+	assert.Equal(t, map[string]string{"order_id": "A-43"}, second.GetMetadata())
+}
+
+func TestEmbeddedRequestBuilderRequiresSigner(t *testing.T) {
+	req, err := NewEmbeddedRequest("0a1b2c3d4e5f", "Offer Letter").
+		AddFile("fixtures/offer_letter.pdf").
+		Build()
+	assert.EqualError(t, err, "embedded signature request requires at least one signer")
+	assert.Equal(t, model.EmbeddedSignatureRequest{}, req, "Should not return a partial request")
+}
+
+func TestEmbeddedRequestBuilderRequiresFile(t *testing.T) {
+	_, err := NewEmbeddedRequest("0a1b2c3d4e5f", "Offer Letter").
+		AddSigner("Jane Doe", "jane@example.com").
+		Build()
+	assert.EqualError(t, err, "embedded signature request requires at least one file or file url")
+
+	_, err = NewEmbeddedRequest("0a1b2c3d4e5f", "Offer Letter").
+		AddSigner("Jane Doe", "jane@example.com").
+		AddFileURL("https://www.pdf995.com/samples/pdf.pdf").
+		Build()
+	assert.Nil(t, err, "Should accept a file url")
+}
+
+func TestEmbeddedRequestBuilderRequiresClientID(t *testing.T) {
+	_, err := NewEmbeddedRequest("", "Offer Letter").
+		AddSigner("Jane Doe", "jane@example.com").
+		AddFile("fixtures/offer_letter.pdf").
+		Build()
+	assert.EqualError(t, err, "embedded signature request requires a client id")
+}
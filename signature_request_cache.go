@@ -0,0 +1,75 @@
+package hellosign
+
+import (
+	"container/list"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"sync"
+)
+
+// SignatureRequestCache keeps the most recently fetched completed signature requests, evicting the least recently used once full.
+// Completed requests no longer change, so they can be served without asking HelloSign again.
+type SignatureRequestCache struct {
+	size int
+
+	mu      sync.Mutex
+	order   *list.List // Front is the most recently used.
+	entries map[string]*list.Element
+}
+
+type signatureRequestCacheEntry struct {
+	id               string
+	signatureRequest *model.SignatureRequest
+}
+
+// NewSignatureRequestCache creates a SignatureRequestCache holding at most size signature requests
+func NewSignatureRequestCache(size int) *SignatureRequestCache {
+	return &SignatureRequestCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached signature request for id
+func (c *SignatureRequestCache) Get(id string) (*model.SignatureRequest, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*signatureRequestCacheEntry).signatureRequest, true
+}
+
+// Add caches the signature request under its id if it is complete, incomplete requests are ignored
+func (c *SignatureRequestCache) Add(signatureRequest *model.SignatureRequest) {
+	if !signatureRequest.GetIsComplete() || c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := signatureRequest.GetSignatureRequestID()
+	if elem, ok := c.entries[id]; ok {
+		elem.Value.(*signatureRequestCacheEntry).signatureRequest = signatureRequest
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[id] = c.order.PushFront(&signatureRequestCacheEntry{id: id, signatureRequest: signatureRequest})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*signatureRequestCacheEntry).id)
+	}
+}
+
+// Len returns the number of cached signature requests
+func (c *SignatureRequestCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,86 @@
+package hellosign
+
+import (
+	"fmt"
+	"github.com/DeputyApp/hellosign-go-sdk/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestGetSignatureRequestCachedServesCompleteRequests(t *testing.T) {
+	gets := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		gets++
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"6b6f2d2a9f3b4f0d8e1c7a5b3d9e1f2a4c6b8d0e","is_complete":true}}`), nil
+	})
+	client.SignatureRequestCache = NewSignatureRequestCache(10)
+
+	res, fromCache, err := client.GetSignatureRequestCached("6b6f2d2a9f3b4f0d8e1c7a5b3d9e1f2a4c6b8d0e")
+	require.Nil(t, err, "Should not return error")
+	assert.False(t, fromCache, "Should fetch the first time")
+	assert.True(t, res.GetIsComplete())
+
+	cached, fromCache, err := client.GetSignatureRequestCached("6b6f2d2a9f3b4f0d8e1c7a5b3d9e1f2a4c6b8d0e")
+	require.Nil(t, err, "Should not return error")
+	assert.True(t, fromCache, "Should serve a completed request from the cache")
+	assert.Equal(t, res, cached)
+
+	_, err = client.GetSignatureRequest("6b6f2d2a9f3b4f0d8e1c7a5b3d9e1f2a4c6b8d0e")
+	require.Nil(t, err, "Should not return error")
+	assert.Equal(t, 1, gets, "Should only call the API once")
+}
+
+func TestGetSignatureRequestCachedRefetchesIncompleteRequests(t *testing.T) {
+	gets := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		gets++
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"6b6f2d2a9f3b4f0d8e1c7a5b3d9e1f2a4c6b8d0e","is_complete":false}}`), nil
+	})
+	client.SignatureRequestCache = NewSignatureRequestCache(10)
+
+	for i := 0; i < 3; i++ {
+		_, fromCache, err := client.GetSignatureRequestCached("6b6f2d2a9f3b4f0d8e1c7a5b3d9e1f2a4c6b8d0e")
+		require.Nil(t, err, "Should not return error")
+		assert.False(t, fromCache, "Should not cache an incomplete request")
+	}
+	assert.Equal(t, 3, gets, "Should call the API every time")
+	assert.Equal(t, 0, client.SignatureRequestCache.Len())
+}
+
+func TestGetSignatureRequestWithoutCache(t *testing.T) {
+	gets := 0
+	client := createStubClient(func(r *http.Request) (*http.Response, error) {
+		gets++
+		return stubResponse(200, `{"signature_request":{"signature_request_id":"6b6f2d2a9f3b4f0d8e1c7a5b3d9e1f2a4c6b8d0e","is_complete":true}}`), nil
+	})
+
+	for i := 0; i < 2; i++ {
+		_, fromCache, err := client.GetSignatureRequestCached("6b6f2d2a9f3b4f0d8e1c7a5b3d9e1f2a4c6b8d0e")
+		require.Nil(t, err, "Should not return error")
+		assert.False(t, fromCache, "Should not cache unless a cache is set")
+	}
+	assert.Equal(t, 2, gets)
+}
+
+func TestSignatureRequestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewSignatureRequestCache(2)
+	for i := 1; i <= 2; i++ {
+		cache.Add(&model.SignatureRequest{SignatureRequestID: fmt.Sprintf("sr_%d", i), IsComplete: true})
+	}
+
+	_, ok := cache.Get("sr_1")
+	require.True(t, ok)
+
+	cache.Add(&model.SignatureRequest{SignatureRequestID: "sr_3", IsComplete: true})
+	assert.Equal(t, 2, cache.Len(), "Should stay within its size")
+
+	_, ok = cache.Get("sr_2")
+	assert.False(t, ok, "Should evict the least recently used request")
+	for _, id := range []string{"sr_1", "sr_3"} {
+		res, ok := cache.Get(id)
+		assert.True(t, ok, "Should keep %s", id)
+		assert.Equal(t, id, res.GetSignatureRequestID())
+	}
+}